@@ -0,0 +1,91 @@
+// cmd/geoimport/main.go
+// GeoNames 형식 덤프(https://download.geonames.org/export/dump/)를 geo_places 테이블로 가져오는 도구
+//
+// 사용법:
+//
+//	go run ./cmd/geoimport -file KR.txt -admin1 admin1CodesASCII.txt
+//	go run ./cmd/geoimport -file JP.txt -admin1 admin1CodesASCII.txt -classes P,S,L
+//
+// 국가별 덤프(KR.txt 등) 사용을 권장합니다. 서버는 시작할 때 테이블을 읽어 지명 사전을 만듭니다
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/joho/godotenv"
+
+	"tripwand-backend/internal/database"
+	"tripwand-backend/internal/geo"
+	"tripwand-backend/internal/models"
+)
+
+func main() {
+	file := flag.String("file", "", "GeoNames 덤프 파일 경로 (필수)")
+	admin1 := flag.String("admin1", "", "admin1CodesASCII.txt 경로 (지역 이름용, 선택)")
+	countries := flag.String("countries", "", "가져올 국가 코드 (쉼표 구분, 예: KR,JP)")
+	classes := flag.String("classes", "P,S,L,T,H", "가져올 GeoNames 분류 (쉼표 구분, 비우면 전체)")
+	flag.Parse()
+
+	if *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// .env 파일 로드
+	if err := godotenv.Load(); err != nil {
+		log.Println("Warning: .env file not found")
+	}
+
+	if err := database.Connect(); err != nil {
+		log.Fatalf("❌ Failed to connect to database: %v", err)
+	}
+	defer database.Close()
+
+	if err := database.DB.AutoMigrate(&models.GeoPlace{}); err != nil {
+		log.Fatalf("❌ Failed to migrate geo_places: %v", err)
+	}
+
+	opts := geo.ImportOptions{
+		Countries:      csvSet(*countries),
+		FeatureClasses: csvSet(*classes),
+	}
+
+	if *admin1 != "" {
+		f, err := os.Open(*admin1)
+		if err != nil {
+			log.Fatalf("❌ Failed to open admin1 codes: %v", err)
+		}
+		opts.Admin1Names, err = geo.ParseAdmin1Codes(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("❌ Failed to read admin1 codes: %v", err)
+		}
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		log.Fatalf("❌ Failed to open dump: %v", err)
+	}
+	defer f.Close()
+
+	log.Printf("📥 Importing %s...", *file)
+	imported, err := geo.ImportGeoNames(database.DB, f, opts)
+	if err != nil {
+		log.Fatalf("❌ Import failed after %d places: %v", imported, err)
+	}
+	log.Printf("✅ Imported %d places", imported)
+}
+
+// csvSet 쉼표 구분 문자열을 집합으로 변환 (대문자 기준)
+func csvSet(value string) map[string]bool {
+	set := make(map[string]bool)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.ToUpper(strings.TrimSpace(item)); item != "" {
+			set[item] = true
+		}
+	}
+	return set
+}
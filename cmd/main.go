@@ -3,6 +3,10 @@ package main
 import (
 	"log"
 	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
@@ -10,8 +14,13 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/joho/godotenv"
 
+	"tripwand-backend/internal/api/handlers"
 	"tripwand-backend/internal/api/routes"
+	"tripwand-backend/internal/currency"
 	"tripwand-backend/internal/database"
+	"tripwand-backend/internal/export"
+	"tripwand-backend/internal/geo"
+	"tripwand-backend/internal/jobs"
 	"tripwand-backend/internal/llm"
 	"tripwand-backend/internal/models"
 )
@@ -24,17 +33,59 @@ func main() {
 		log.Println("Warning: .env file not found")
 	}
 
+	// 환율표 파일 (설정 시 내장 환율표 대신 사용, DB에 더 최근 환율표가 있으면 그것을 사용)
+	if path := os.Getenv("CURRENCY_RATES_FILE"); path != "" {
+		if err := loadRatesFile(path); err != nil {
+			log.Printf("⚠️ Failed to load exchange rates file: %v", err)
+		}
+	}
+
 	// 데이터베이스 연결
 	log.Println("🔌 Connecting to database...")
+	dbConnected := false
 	if err := database.Connect(); err != nil {
 		log.Printf("⚠️ Failed to connect to database: %v", err)
 		log.Println("⚠️ Starting without database connection - some features may not work")
 	} else {
+		dbConnected = true
+
 		// 데이터베이스 마이그레이션
 		log.Println("🔄 Running database migrations...")
 		if err := runMigrations(); err != nil {
 			log.Printf("⚠️ Failed to run migrations: %v", err)
 		}
+
+		// 지명 사전 (내장 사전 + cmd/geoimport로 가져온 geo_places)
+		if gazetteer, err := geo.LoadFromDB(database.DB); err != nil {
+			log.Printf("⚠️ Failed to load gazetteer: %v", err)
+		} else {
+			geo.SetDefault(gazetteer)
+			log.Printf("🗺️ Gazetteer loaded with %d places", gazetteer.Len())
+		}
+
+		// 환율표 (업로드된 환율표가 파일/내장 환율표보다 최근이면 사용)
+		if table, err := currency.LoadFromDB(database.DB); err != nil {
+			log.Printf("⚠️ Failed to load exchange rates: %v", err)
+		} else {
+			currency.SetDefault(currency.Newer(currency.Default(), table))
+		}
+		log.Printf("💱 Exchange rates as of %s", currency.Default().Date.Format(currency.DateLayout))
+
+		// 다른 인스턴스에서 업로드한 환율표 반영 작업
+		jobs.StartRatesRefreshJob(time.Duration(getEnvInt("RATES_REFRESH_MINUTES", 5)) * time.Minute)
+
+		// 휴지통 영구 삭제 작업 (보관 기간 경과 후)
+		handlers.TrashRetention = time.Duration(getEnvInt("TRASH_RETENTION_DAYS", 30)) * 24 * time.Hour
+		jobs.StartPlanPurgeJob(time.Hour, handlers.TrashRetention)
+
+		// 여행 통계 스냅샷 갱신 작업
+		jobs.StartStatsRefreshJob(time.Duration(getEnvInt("STATS_REFRESH_MINUTES", 10)) * time.Minute)
+
+		// 조회수 일괄 반영 작업
+		jobs.StartViewFlushJob(time.Duration(getEnvInt("VIEW_FLUSH_SECONDS", 10)) * time.Second)
+
+		// 트렌딩 점수 재계산 작업
+		jobs.StartTrendingRefreshJob(time.Duration(getEnvInt("TRENDING_REFRESH_MINUTES", 15)) * time.Minute)
 	}
 
 	// Gemma 클라이언트 초기화
@@ -73,8 +124,9 @@ func main() {
 	// CORS 설정 (개발 및 프로덕션 프론트엔드 지원)
 	app.Use(cors.New(cors.Config{
 		AllowOrigins:     getEnv("ALLOWED_ORIGINS", "http://localhost:5173,http://127.0.0.1:5173"),
-		AllowHeaders:     "Origin, Content-Type, Accept, Authorization",
-		AllowMethods:     "GET, POST, PUT, DELETE, OPTIONS",
+		AllowHeaders:     "Origin, Content-Type, Accept, Authorization, X-Guest-Token",
+		ExposeHeaders:    "X-Guest-Token",
+		AllowMethods:     "GET, POST, PUT, PATCH, DELETE, OPTIONS",
 		AllowCredentials: true,
 	}))
 
@@ -84,6 +136,21 @@ func main() {
 	// API 라우트 그룹
 	api := app.Group("/api/v1")
 
+	// 문서 내보내기 템플릿 (설정 시 내장 템플릿 대신 사용)
+	if dir := os.Getenv("EXPORT_TEMPLATE_DIR"); dir != "" {
+		if err := export.UseTemplateDir(dir); err != nil {
+			log.Printf("⚠️ Failed to use export templates: %v", err)
+		}
+	}
+
+	// X-Forwarded-For에 주소를 덧붙이는 신뢰 프록시 수 (조회수 중복 제거용 클라이언트 IP 판별)
+	if hops, err := strconv.Atoi(os.Getenv("TRUSTED_PROXY_HOPS")); err == nil && hops >= 0 {
+		handlers.TrustedProxyHops = hops
+	}
+
+	// 공유 링크 주소 (프론트엔드 공유 페이지, 미설정 시 API 주소 사용)
+	handlers.ShareBaseURL = getEnv("SHARE_BASE_URL", "")
+
 	// 여행 관련 라우트 설정
 	routes.SetupTravelRoutes(api, gemmaClient)
 
@@ -98,20 +165,50 @@ func main() {
 	log.Printf("   Travel API: http://localhost:%s/api/v1/travel/generate", port)
 	log.Printf("   Plans API: http://localhost:%s/api/v1/travel/plans", port)
 
+	// 종료 신호(배포, SIGTERM)를 받으면 진행 중인 요청을 마무리하고 종료
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-quit
+		log.Println("🛑 Shutting down server...")
+		if err := app.ShutdownWithTimeout(10 * time.Second); err != nil {
+			log.Printf("⚠️ Failed to shut down gracefully: %v", err)
+		}
+	}()
+
 	if err := app.Listen(":" + port); err != nil {
 		log.Fatal("Failed to start server:", err)
 	}
+
+	// 버퍼에 남은 조회수 반영
+	if dbConnected {
+		jobs.FlushViews()
+	}
 }
 
 // runMigrations 데이터베이스 마이그레이션 실행
 func runMigrations() error {
 	// 기존 모델들
 	if err := database.DB.AutoMigrate(
-		//&models.User{},
+		&models.User{},
+		&models.OAuthAccount{},
+		&models.UserSession{}, // 인증 미들웨어의 세션 확인에 필요
 		//&models.ChatRoom{},
 		//&models.Message{},
 		//&models.VectorEmbedding{},
 		&models.TravelPlans{}, // 새로 추가된 여행 계획 모델
+		&models.PlanRevision{},
+		&models.PlanLeg{},
+		&models.PlanDailyView{},
+		&models.PlanLike{},
+		&models.PlanCollection{},
+		&models.PlanBookmark{},
+		&models.PlanComment{},
+		&models.PlanReview{},
+		&models.ModerationItem{},
+		&models.ContentReport{},
+		&models.GeoPlace{},
+		&models.ExchangeRateSnapshot{},
 	); err != nil {
 		return err
 	}
@@ -151,6 +248,40 @@ func healthCheck(c *fiber.Ctx) error {
 			"POST /api/v1/travel/generate - 여행 일정 생성",
 			"GET /api/v1/travel/plans - 저장된 계획 목록",
 			"GET /api/v1/travel/plans/{id} - 계획 상세 조회",
+			"PATCH /api/v1/travel/plans/{id}/visibility - 계획 공개 여부 변경",
+			"PATCH /api/v1/travel/plans/{id} - 계획 수정",
+			"DELETE /api/v1/travel/plans/{id} - 계획 삭제 (휴지통)",
+			"POST /api/v1/travel/plans/{id}/restore - 휴지통 계획 복원",
+			"POST /api/v1/travel/plans/{id}/fork - 공개 계획 내 계정으로 복사",
+			"POST /api/v1/travel/plans/{id}/days/{day}/regenerate - 일차/시간대 일정 재생성",
+			"GET /api/v1/travel/plans/{id}/revisions - 계획 변경 이력",
+			"GET /api/v1/travel/plans/{id}/revisions/diff - 리비전 비교",
+			"POST /api/v1/travel/plans/{id}/revisions/{rev}/revert - 리비전 되돌리기",
+			"GET /api/v1/travel/stats - 여행 계획 통계",
+			"GET /api/v1/travel/trending - 트렌딩 목적지/계획",
+			"GET /api/v1/me/plans - 내 여행 계획 목록",
+			"GET /api/v1/me/plans/trash - 휴지통 목록",
+			"PUT|DELETE /api/v1/travel/plans/{id}/like - 좋아요/취소",
+			"PUT|DELETE /api/v1/travel/plans/{id}/bookmark - 북마크/해제",
+			"GET|POST /api/v1/travel/plans/{id}/comments - 댓글 목록/작성",
+			"GET|POST /api/v1/travel/plans/{id}/reviews - 리뷰 목록/작성",
+			"GET /api/v1/me/likes - 내 좋아요 목록",
+			"GET /api/v1/me/bookmarks - 내 북마크 목록",
+			"GET|POST /api/v1/me/collections - 내 컬렉션 목록/생성",
+			"POST /api/v1/me/claim-guest-plans - 게스트 계획 내 계정으로 이전",
+			"POST /api/v1/travel/reports - 계획/댓글 신고",
+			"GET|POST|DELETE /api/v1/travel/plans/{id}/share - 공유 링크 조회/발급/해제",
+			"GET /api/v1/share/{token} - 공유 링크로 계획 조회",
+			"GET /api/v1/travel/plans/{id}/export?format=md|html - 문서 내보내기",
+			"GET /api/v1/travel/plans/{id}/export.ics - 캘린더(.ics) 내보내기",
+			"GET /api/v1/travel/plans/{id}/export.geojson|kml - 지도 데이터 내보내기",
+			"GET /api/v1/travel/plans/{id}/feasibility?mode=walking|transit|car - 이동 가능성 검사",
+			"GET /api/v1/travel/plans/{id}/constraints?constraints=wheelchair,halal - 여행자 제약 조건 검사",
+			"POST /api/v1/geo/resolve - 장소 이름 좌표 일괄 검색",
+			"GET /api/v1/currency/rates - 비용 환산 환율표",
+			"GET /api/v1/admin/moderation - 검토 큐 (관리자)",
+			"POST /api/v1/admin/currency/rates - 환율표 업로드 (관리자)",
+			"POST /api/v1/admin/moderation/{id}/approve|hide|delete - 검토 처리 (관리자)",
 		},
 	})
 }
@@ -244,6 +375,23 @@ func handleGemmaGenerate(c *fiber.Ctx) error {
 	})
 }
 
+// loadRatesFile date,currency,krw_per_unit 형식의 환율표 파일을 기본 환율표로 사용
+func loadRatesFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	table, err := currency.Parse(f)
+	if err != nil {
+		return err
+	}
+	table.Source = path
+	currency.SetDefault(table)
+	return nil
+}
+
 // getEnv 환경 변수 헬퍼 함수
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -251,3 +399,11 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// getEnvInt 정수 환경 변수 헬퍼 함수
+func getEnvInt(key string, defaultValue int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil && value > 0 {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,50 @@
+// internal/api/handlers/constraints.go
+package handlers
+
+import (
+	"tripwand-backend/internal/constraints"
+	"tripwand-backend/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CheckPlanConstraints 저장된 계획이 여행자 제약 조건에 맞는지 검사
+// @Summary 여행자 제약 조건 검사
+// @Description 활동 요약과 상세를 키워드 규칙으로 검사해 접근성, 식단, 어린이/반려동물 동반 조건에 맞지 않을 수 있는 활동을 반환합니다. constraints를 지정하지 않으면 계획에 저장된 조건으로 검사합니다
+// @Tags travel
+// @Produce json
+// @Param id path string true "여행 계획 ID"
+// @Param constraints query string false "검사할 조건 (쉼표 구분: wheelchair, stroller, limited_walking, vegetarian, vegan, halal, gluten_free, kids, pets)"
+// @Success 200 {object} constraints.Report "검사 결과"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/constraints [get]
+func (h *TravelHandler) CheckPlanConstraints(c *fiber.Ctx) error {
+	tags, err := models.ParseConstraintTags(c.Query("constraints"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "지원하지 않는 여행자 조건입니다",
+			"error":   err.Error(),
+		})
+	}
+
+	plan, data, err := findExportablePlan(c)
+	if err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		tags = plan.Constraints
+	}
+
+	report := constraints.Check(data, tags)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    report,
+		"meta": fiber.Map{
+			"plan_id":   plan.ID,
+			"satisfied": report.Satisfied(),
+		},
+	})
+}
@@ -0,0 +1,144 @@
+// internal/api/handlers/currency.go
+package handlers
+
+import (
+	"strings"
+	"time"
+
+	"tripwand-backend/internal/currency"
+	"tripwand-backend/internal/database"
+	"tripwand-backend/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// UploadRatesRequest 환율표 업로드 요청 (외화 1단위당 원화)
+type UploadRatesRequest struct {
+	Date   string             `json:"date" example:"2026-10-17"`
+	Source string             `json:"source" example:"한국은행 매매기준율"`
+	Rates  map[string]float64 `json:"rates"`
+}
+
+// GetExchangeRates 현재 사용 중인 환율표 조회
+// @Summary 환율표 조회
+// @Description 비용 환산에 사용하는 환율표(외화 1단위당 원화)와 기준일을 반환합니다
+// @Tags currency
+// @Produce json
+// @Success 200 {object} currency.Table "환율표"
+// @Router /api/v1/currency/rates [get]
+func (h *TravelHandler) GetExchangeRates(c *fiber.Ctx) error {
+	table := currency.Default()
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    table,
+		"meta": fiber.Map{
+			"base":       currency.Base,
+			"currencies": table.Currencies(),
+			"rates_date": table.Date.Format(currency.DateLayout),
+		},
+	})
+}
+
+// UploadExchangeRates 새 환율표 업로드 (관리자 전용)
+// @Summary 환율표 업로드
+// @Description JSON({date, source, rates}) 또는 date,currency,krw_per_unit 형식의 CSV(Content-Type: text/csv)로 환율표를 올립니다. 같은 기준일은 덮어쓰며, 현재 환율표보다 기준일이 같거나 최근이면 바로 적용됩니다
+// @Tags admin
+// @Accept json
+// @Accept text/csv
+// @Produce json
+// @Security BearerAuth
+// @Param request body UploadRatesRequest true "환율표"
+// @Success 200 {object} currency.Table "저장된 환율표"
+// @Failure 400 {object} map[string]interface{} "잘못된 환율표"
+// @Failure 403 {object} map[string]interface{} "관리자 권한 필요"
+// @Failure 500 {object} map[string]interface{} "서버 오류"
+// @Router /api/v1/admin/currency/rates [post]
+func (h *TravelHandler) UploadExchangeRates(c *fiber.Ctx) error {
+	var table *currency.Table
+	if strings.HasPrefix(c.Get(fiber.HeaderContentType), "text/csv") {
+		parsed, err := currency.Parse(strings.NewReader(string(c.Body())))
+		if err != nil {
+			return c.Status(400).JSON(fiber.Map{
+				"success": false,
+				"message": "환율표 형식이 올바르지 않습니다",
+				"error":   err.Error(),
+			})
+		}
+		table = parsed
+		table.Source = c.Query("source", "upload")
+	} else {
+		var req UploadRatesRequest
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(400).JSON(fiber.Map{
+				"success": false,
+				"message": "잘못된 요청 형식입니다",
+				"error":   err.Error(),
+			})
+		}
+
+		date, err := time.Parse(currency.DateLayout, req.Date)
+		if err != nil {
+			return c.Status(400).JSON(fiber.Map{
+				"success": false,
+				"message": "date는 YYYY-MM-DD 형식이어야 합니다",
+			})
+		}
+
+		table = &currency.Table{Date: date, Source: req.Source, Rates: make(map[string]float64, len(req.Rates))}
+		for code, rate := range req.Rates {
+			table.Rates[strings.ToUpper(code)] = rate
+		}
+		if table.Source == "" {
+			table.Source = "upload"
+		}
+		if err := table.Validate(); err != nil {
+			return c.Status(400).JSON(fiber.Map{
+				"success": false,
+				"message": "환율표 형식이 올바르지 않습니다",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	if err := currency.Save(database.DB, table, currentUserID(c)); err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "환율표 저장 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	// 과거 기준일의 환율표는 기록만 하고 현재 환율표는 유지
+	current := currency.Newer(currency.Default(), table)
+	currency.SetDefault(current)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    table,
+		"meta": fiber.Map{
+			"applied":    current == table,
+			"rates_date": current.Date.Format(currency.DateLayout),
+		},
+	})
+}
+
+// convertCosts 일정 비용을 요청한 통화로 환산 (통화를 지정하지 않았으면 nil)
+func convertCosts(code string, data *models.TravelResponse) (*currency.Conversion, error) {
+	if code == "" {
+		return nil, nil
+	}
+	conversion, err := currency.Default().Convert(data, code)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "지원하지 않는 통화입니다")
+	}
+	return conversion, nil
+}
+
+// planConversion 저장된 계획의 비용 환산
+func planConversion(plan *models.TravelPlans, code string) (*currency.Conversion, error) {
+	data, err := models.ParsePlanData(plan.PlanData)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "여행 일정 처리 중 오류가 발생했습니다")
+	}
+	return convertCosts(strings.ToUpper(code), data)
+}
@@ -0,0 +1,477 @@
+// internal/api/handlers/engagement.go
+package handlers
+
+import (
+	"strings"
+
+	"tripwand-backend/internal/database"
+	"tripwand-backend/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// BookmarkRequest 북마크 요청 (컬렉션 지정은 선택)
+type BookmarkRequest struct {
+	CollectionID *uint `json:"collection_id,omitempty" example:"3"`
+}
+
+// CollectionRequest 컬렉션 생성/수정 요청
+type CollectionRequest struct {
+	Name        *string `json:"name,omitempty" example:"가을 여행 후보"`
+	Description *string `json:"description,omitempty" example:"10월에 가고 싶은 곳"`
+}
+
+// LikePlan 여행 계획 좋아요 (여러 번 호출해도 한 번만 반영)
+// @Summary 좋아요
+// @Description 공개 여행 계획에 좋아요를 누릅니다. 이미 누른 경우에도 성공을 반환합니다
+// @Tags engagement
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Success 200 {object} map[string]interface{} "좋아요 상태와 개수"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/like [put]
+func (h *TravelHandler) LikePlan(c *fiber.Ctx) error {
+	userID := currentUserID(c)
+	plan, err := findVisiblePlan(c, userID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := models.LikePlan(database.DB, *userID, plan.ID); err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "좋아요 처리 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return respondLikeState(c, plan.ID, true)
+}
+
+// UnlikePlan 여행 계획 좋아요 취소 (여러 번 호출해도 한 번만 반영)
+// @Summary 좋아요 취소
+// @Description 좋아요를 취소합니다. 누르지 않은 경우에도 성공을 반환합니다
+// @Tags engagement
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Success 200 {object} map[string]interface{} "좋아요 상태와 개수"
+// @Router /api/v1/travel/plans/{id}/like [delete]
+func (h *TravelHandler) UnlikePlan(c *fiber.Ctx) error {
+	userID := currentUserID(c)
+	planID, err := c.ParamsInt("id")
+	if err != nil || planID < 1 {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "여행 계획 ID가 올바르지 않습니다",
+		})
+	}
+
+	if _, err := models.UnlikePlan(database.DB, *userID, uint(planID)); err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "좋아요 취소 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return respondLikeState(c, uint(planID), false)
+}
+
+// BookmarkPlan 여행 계획 북마크 (컬렉션 지정/변경 포함)
+// @Summary 북마크
+// @Description 여행 계획을 북마크합니다. collection_id를 지정하면 해당 컬렉션으로 옮기고, 생략하면 컬렉션 없이 저장합니다
+// @Tags engagement
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Param request body BookmarkRequest false "컬렉션"
+// @Success 200 {object} models.PlanBookmark "북마크"
+// @Failure 404 {object} map[string]interface{} "계획 또는 컬렉션을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/bookmark [put]
+func (h *TravelHandler) BookmarkPlan(c *fiber.Ctx) error {
+	var req BookmarkRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(400).JSON(fiber.Map{
+				"success": false,
+				"message": "잘못된 요청 형식입니다",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	userID := currentUserID(c)
+	plan, err := findVisiblePlan(c, userID)
+	if err != nil {
+		return err
+	}
+
+	if req.CollectionID != nil {
+		if _, err := findOwnedCollection(*userID, *req.CollectionID); err != nil {
+			return err
+		}
+	}
+
+	bookmark, err := models.BookmarkPlan(database.DB, *userID, plan.ID, req.CollectionID)
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "북마크 처리 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    bookmark,
+	})
+}
+
+// RemoveBookmark 여행 계획 북마크 해제
+// @Summary 북마크 해제
+// @Description 북마크를 해제합니다. 북마크하지 않은 경우에도 성공을 반환합니다
+// @Tags engagement
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Success 200 {object} map[string]interface{} "해제 완료"
+// @Router /api/v1/travel/plans/{id}/bookmark [delete]
+func (h *TravelHandler) RemoveBookmark(c *fiber.Ctx) error {
+	userID := currentUserID(c)
+	if err := database.DB.Where("user_id = ? AND plan_id = ?", *userID, c.Params("id")).
+		Delete(&models.PlanBookmark{}).Error; err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "북마크 해제 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"bookmarked": false,
+		},
+	})
+}
+
+// GetMyLikes 내가 좋아요한 여행 계획 목록
+// @Summary 좋아요 목록
+// @Description 내가 좋아요한 여행 계획을 최근 순으로 조회합니다
+// @Tags me
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "페이지 번호" default(1)
+// @Param limit query int false "페이지당 항목 수" default(10)
+// @Success 200 {array} models.TravelPlans "여행 계획 목록"
+// @Router /api/v1/me/likes [get]
+func (h *TravelHandler) GetMyLikes(c *fiber.Ctx) error {
+	userID := currentUserID(c)
+	page, limit, offset := pagination(c)
+
+	query := models.VisiblePlansFor(database.DB.Model(&models.TravelPlans{}), *userID).
+		Joins("JOIN plan_likes ON plan_likes.plan_id = travel_plans.id AND plan_likes.user_id = ?", *userID).
+		Session(&gorm.Session{})
+
+	var plans []models.TravelPlans
+	var total int64
+
+	query.Count(&total)
+
+	if err := query.Order("plan_likes.created_at DESC").Offset(offset).Limit(limit).Find(&plans).Error; err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "좋아요 목록 조회 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    plans,
+		"meta":    paginationMeta(page, limit, total),
+	})
+}
+
+// GetMyBookmarks 내 북마크 목록
+// @Summary 북마크 목록
+// @Description 내 북마크를 최근 순으로 조회합니다. collection_id로 컬렉션별 조회, collection_id=0으로 컬렉션 미지정 북마크만 조회할 수 있습니다
+// @Tags me
+// @Produce json
+// @Security BearerAuth
+// @Param collection_id query int false "컬렉션 ID (0이면 미지정)"
+// @Param page query int false "페이지 번호" default(1)
+// @Param limit query int false "페이지당 항목 수" default(10)
+// @Success 200 {array} models.PlanBookmark "북마크 목록"
+// @Router /api/v1/me/bookmarks [get]
+func (h *TravelHandler) GetMyBookmarks(c *fiber.Ctx) error {
+	userID := currentUserID(c)
+	page, limit, offset := pagination(c)
+
+	query := models.VisiblePlansFor(database.DB.Model(&models.PlanBookmark{}), *userID).
+		Joins("JOIN travel_plans ON travel_plans.id = plan_bookmarks.plan_id AND travel_plans.deleted_at IS NULL").
+		Where("plan_bookmarks.user_id = ?", *userID)
+
+	if c.Query("collection_id") != "" {
+		if collectionID := c.QueryInt("collection_id", 0); collectionID > 0 {
+			query = query.Where("plan_bookmarks.collection_id = ?", collectionID)
+		} else {
+			query = query.Where("plan_bookmarks.collection_id IS NULL")
+		}
+	}
+	query = query.Session(&gorm.Session{})
+
+	var bookmarks []models.PlanBookmark
+	var total int64
+
+	query.Count(&total)
+
+	if err := query.Preload("Plan").Order("plan_bookmarks.created_at DESC").Offset(offset).Limit(limit).Find(&bookmarks).Error; err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "북마크 목록 조회 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    bookmarks,
+		"meta":    paginationMeta(page, limit, total),
+	})
+}
+
+// GetMyCollections 내 컬렉션 목록
+// @Summary 컬렉션 목록
+// @Description 내 북마크 컬렉션과 각 컬렉션의 북마크 수를 조회합니다
+// @Tags me
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.PlanCollection "컬렉션 목록"
+// @Router /api/v1/me/collections [get]
+func (h *TravelHandler) GetMyCollections(c *fiber.Ctx) error {
+	userID := currentUserID(c)
+
+	var collections []models.PlanCollection
+	if err := database.DB.Model(&models.PlanCollection{}).
+		Select("plan_collections.*, (SELECT COUNT(*) FROM plan_bookmarks b WHERE b.collection_id = plan_collections.id) AS bookmark_count").
+		Where("user_id = ?", *userID).
+		Order("created_at ASC").
+		Find(&collections).Error; err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "컬렉션 목록 조회 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    collections,
+	})
+}
+
+// CreateCollection 컬렉션 생성
+// @Summary 컬렉션 생성
+// @Description 북마크를 묶을 컬렉션을 만듭니다. 이름은 사용자별로 고유해야 합니다
+// @Tags me
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CollectionRequest true "컬렉션 정보"
+// @Success 201 {object} models.PlanCollection "생성된 컬렉션"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Failure 409 {object} map[string]interface{} "같은 이름의 컬렉션 존재"
+// @Router /api/v1/me/collections [post]
+func (h *TravelHandler) CreateCollection(c *fiber.Ctx) error {
+	var req CollectionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "잘못된 요청 형식입니다",
+			"error":   err.Error(),
+		})
+	}
+
+	userID := currentUserID(c)
+	name, err := validateCollectionName(*userID, req.Name, 0)
+	if err != nil {
+		return err
+	}
+
+	collection := models.PlanCollection{
+		UserID:      *userID,
+		Name:        name,
+		Description: getStringValue(req.Description),
+	}
+	if err := database.DB.Create(&collection).Error; err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "컬렉션 생성 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(201).JSON(fiber.Map{
+		"success": true,
+		"data":    collection,
+	})
+}
+
+// UpdateCollection 컬렉션 이름/설명 수정
+// @Summary 컬렉션 수정
+// @Tags me
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param cid path int true "컬렉션 ID"
+// @Param request body CollectionRequest true "수정할 항목"
+// @Success 200 {object} models.PlanCollection "수정된 컬렉션"
+// @Failure 404 {object} map[string]interface{} "컬렉션을 찾을 수 없음"
+// @Failure 409 {object} map[string]interface{} "같은 이름의 컬렉션 존재"
+// @Router /api/v1/me/collections/{cid} [patch]
+func (h *TravelHandler) UpdateCollection(c *fiber.Ctx) error {
+	var req CollectionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "잘못된 요청 형식입니다",
+			"error":   err.Error(),
+		})
+	}
+
+	userID := currentUserID(c)
+	collectionID, _ := c.ParamsInt("cid")
+	collection, err := findOwnedCollection(*userID, uint(collectionID))
+	if err != nil {
+		return err
+	}
+
+	updates := map[string]interface{}{}
+	if req.Name != nil {
+		name, err := validateCollectionName(*userID, req.Name, collection.ID)
+		if err != nil {
+			return err
+		}
+		updates["name"] = name
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+
+	if len(updates) == 0 {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "수정할 항목이 없습니다",
+		})
+	}
+
+	if err := database.DB.Model(collection).Updates(updates).Error; err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "컬렉션 수정 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    collection,
+	})
+}
+
+// DeleteCollection 컬렉션 삭제 (북마크는 유지)
+// @Summary 컬렉션 삭제
+// @Description 컬렉션을 삭제합니다. 안에 있던 북마크는 컬렉션 미지정 상태로 남습니다
+// @Tags me
+// @Produce json
+// @Security BearerAuth
+// @Param cid path int true "컬렉션 ID"
+// @Success 200 {object} map[string]interface{} "삭제 완료"
+// @Failure 404 {object} map[string]interface{} "컬렉션을 찾을 수 없음"
+// @Router /api/v1/me/collections/{cid} [delete]
+func (h *TravelHandler) DeleteCollection(c *fiber.Ctx) error {
+	userID := currentUserID(c)
+	collectionID, _ := c.ParamsInt("cid")
+	collection, err := findOwnedCollection(*userID, uint(collectionID))
+	if err != nil {
+		return err
+	}
+
+	if err := models.DeleteCollection(database.DB, collection); err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "컬렉션 삭제 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "컬렉션이 삭제되었습니다",
+	})
+}
+
+// respondLikeState 좋아요 처리 후 현재 상태와 개수 응답
+func respondLikeState(c *fiber.Ctx, planID uint, liked bool) error {
+	var likeCount int
+	database.DB.Model(&models.TravelPlans{}).Where("id = ?", planID).Select("like_count").Scan(&likeCount)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"liked":      liked,
+			"like_count": likeCount,
+		},
+	})
+}
+
+// findVisiblePlan 경로의 :id 계획 중 사용자에게 보이는 계획(검수를 통과한 공개 계획 또는 본인 계획) 조회
+// 실패 시 *fiber.Error를 반환하며, 앱 ErrorHandler가 응답으로 변환합니다
+func findVisiblePlan(c *fiber.Ctx, userID *uint) (*models.TravelPlans, error) {
+	var plan models.TravelPlans
+	if err := database.DB.Where("id = ?", c.Params("id")).First(&plan).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, "여행 계획을 찾을 수 없습니다")
+	}
+
+	if (!plan.IsPublic || plan.ModerationStatus != models.ModerationApproved) && !isPlanOwner(&plan, userID) {
+		return nil, fiber.NewError(fiber.StatusNotFound, "여행 계획을 찾을 수 없습니다")
+	}
+
+	return &plan, nil
+}
+
+// findOwnedCollection 사용자 본인의 컬렉션 조회
+func findOwnedCollection(userID, collectionID uint) (*models.PlanCollection, error) {
+	var collection models.PlanCollection
+	if err := database.DB.Where("id = ? AND user_id = ?", collectionID, userID).First(&collection).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, "컬렉션을 찾을 수 없습니다")
+	}
+	return &collection, nil
+}
+
+// validateCollectionName 컬렉션 이름 검증 (1~100자, 사용자별 고유)
+func validateCollectionName(userID uint, name *string, excludeID uint) (string, error) {
+	if name == nil {
+		return "", fiber.NewError(fiber.StatusBadRequest, "컬렉션 이름은 필수입니다")
+	}
+
+	trimmed := strings.TrimSpace(*name)
+	if trimmed == "" || len([]rune(trimmed)) > 100 {
+		return "", fiber.NewError(fiber.StatusBadRequest, "컬렉션 이름은 1자 이상 100자 이하여야 합니다")
+	}
+
+	var count int64
+	database.DB.Model(&models.PlanCollection{}).
+		Where("user_id = ? AND name = ? AND id <> ?", userID, trimmed, excludeID).
+		Count(&count)
+	if count > 0 {
+		return "", fiber.NewError(fiber.StatusConflict, "같은 이름의 컬렉션이 이미 있습니다")
+	}
+
+	return trimmed, nil
+}
@@ -0,0 +1,192 @@
+// internal/api/handlers/export.go
+package handlers
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"tripwand-backend/internal/calendar"
+	"tripwand-backend/internal/export"
+	"tripwand-backend/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// defaultExportTimezone 내보내기 시간대 기본값
+const defaultExportTimezone = "Asia/Seoul"
+
+// ExportPlanICS 여행 일정을 iCalendar 파일로 내보내기
+// @Summary 캘린더 파일 내보내기
+// @Description 여행 일정의 각 시간대 활동을 일정으로 변환한 .ics 파일을 반환합니다. Google/Apple 캘린더로 가져올 수 있습니다. 활동에 start_time/end_time이 있으면 그 시각을, 없으면 시간대 기본 시각(아침 09-12시, 오후 13-17시, 저녁 18-20시, 밤 20-22시)을 사용합니다
+// @Tags export
+// @Produce text/calendar
+// @Param id path string true "여행 계획 ID"
+// @Param start_date query string false "1일차 날짜 (YYYY-MM-DD) - 생략하면 계획에 저장된 여행 시작일"
+// @Param tz query string false "시간대 (IANA)" default(Asia/Seoul)
+// @Success 200 {file} file "iCalendar 파일"
+// @Failure 400 {object} map[string]interface{} "잘못된 날짜/시간대"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/export.ics [get]
+func (h *TravelHandler) ExportPlanICS(c *fiber.Ctx) error {
+	loc, err := time.LoadLocation(c.Query("tz", defaultExportTimezone))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "알 수 없는 시간대입니다",
+			"error":   err.Error(),
+		})
+	}
+
+	plan, data, err := findExportablePlan(c)
+	if err != nil {
+		return err
+	}
+
+	startText := c.Query("start_date")
+	if startText == "" && plan.StartDate != nil {
+		startText = plan.StartDate.Format(calendar.DateLayout)
+	}
+	startDate, err := time.ParseInLocation(calendar.DateLayout, startText, loc)
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "start_date는 YYYY-MM-DD 형식이어야 합니다",
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, "text/calendar; charset=utf-8")
+	setAttachment(c, plan, "ics")
+	return c.Send(export.ICS(plan, data, startDate, loc, time.Now()))
+}
+
+// ExportPlan 여행 일정을 Markdown 또는 인쇄용 HTML 문서로 내보내기
+// @Summary 문서 내보내기
+// @Description 일정, 예상 경비, 주의사항을 서버 템플릿으로 렌더링합니다. 제목 등 문구는 계획 언어(ko, en)를 따르며 lang으로 바꿀 수 있습니다. md는 파일로 내려받고 html은 브라우저에서 바로 인쇄할 수 있습니다
+// @Tags export
+// @Produce text/markdown
+// @Produce text/html
+// @Param id path string true "여행 계획 ID"
+// @Param format query string false "형식 (md, html)" default(md)
+// @Param lang query string false "문구 언어 (ko, en) - 생략하면 계획 언어"
+// @Success 200 {file} file "여행 일정 문서"
+// @Failure 400 {object} map[string]interface{} "지원하지 않는 형식"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/export [get]
+func (h *TravelHandler) ExportPlan(c *fiber.Ctx) error {
+	format := c.Query("format", "md")
+	f, ok := export.Formats[format]
+	if !ok {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "format은 md 또는 html이어야 합니다",
+		})
+	}
+
+	plan, data, err := findExportablePlan(c)
+	if err != nil {
+		return err
+	}
+
+	doc := export.NewPlanDocument(plan, data, c.Query("lang", plan.Language))
+	body, err := export.Render(format, doc)
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "문서 생성 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, f.ContentType)
+	// 인쇄용 HTML은 브라우저에서 바로 열리도록 첨부 파일로 지정하지 않음
+	if format != "html" {
+		setAttachment(c, plan, f.Extension)
+	}
+	return c.Send(body)
+}
+
+// ExportPlanGeoJSON 여행 일정의 장소를 GeoJSON으로 내보내기
+// @Summary GeoJSON 내보내기
+// @Description 각 활동에 언급된 장소를 지명 사전으로 좌표화해 Point(일차, 시간대, 방문 순서 포함)와 일차별 이동 경로 LineString을 담은 FeatureCollection을 반환합니다. 좌표를 찾지 못한 장소는 제외됩니다
+// @Tags export
+// @Produce application/geo+json
+// @Param id path string true "여행 계획 ID"
+// @Success 200 {object} map[string]interface{} "GeoJSON FeatureCollection"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/export.geojson [get]
+func (h *TravelHandler) ExportPlanGeoJSON(c *fiber.Ctx) error {
+	plan, data, err := findExportablePlan(c)
+	if err != nil {
+		return err
+	}
+
+	body, err := export.GeoJSON(plan, export.PlanStops(data, plan.Destination, h.gazetteer))
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "지도 데이터 생성 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, "application/geo+json")
+	return c.Send(body)
+}
+
+// ExportPlanKML 여행 일정의 장소를 KML로 내보내기
+// @Summary KML 내보내기
+// @Description GeoJSON 내보내기와 같은 장소와 경로를 일차별 폴더로 묶은 KML 문서로 반환합니다 (Google Earth/My Maps 가져오기용)
+// @Tags export
+// @Produce application/vnd.google-earth.kml+xml
+// @Param id path string true "여행 계획 ID"
+// @Success 200 {file} file "KML 문서"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/export.kml [get]
+func (h *TravelHandler) ExportPlanKML(c *fiber.Ctx) error {
+	plan, data, err := findExportablePlan(c)
+	if err != nil {
+		return err
+	}
+
+	body, err := export.KML(plan, export.PlanStops(data, plan.Destination, h.gazetteer))
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "지도 데이터 생성 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, "application/vnd.google-earth.kml+xml")
+	setAttachment(c, plan, "kml")
+	return c.Send(body)
+}
+
+// findExportablePlan 경로의 :id 계획 중 사용자에게 보이는 계획과 일정 데이터 조회
+// 실패 시 *fiber.Error를 반환하며, 앱 ErrorHandler가 응답으로 변환합니다
+func findExportablePlan(c *fiber.Ctx) (*models.TravelPlans, *models.TravelResponse, error) {
+	plan, err := findVisiblePlan(c, currentUserID(c))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := models.ParsePlanData(plan.PlanData)
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusInternalServerError, "여행 일정 처리 중 오류가 발생했습니다")
+	}
+
+	return plan, data, nil
+}
+
+// setAttachment 다운로드 파일명 지정 (한글 제목은 RFC 5987 형식으로 함께 전달)
+func setAttachment(c *fiber.Ctx, plan *models.TravelPlans, ext string) {
+	fallback := fmt.Sprintf("tripwand-plan-%d.%s", plan.ID, ext)
+	filename := fmt.Sprintf("%s.%s", plan.Title, ext)
+	if plan.Title == "" {
+		filename = fallback
+	}
+
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"; filename*=UTF-8''%s`,
+		fallback, url.PathEscape(filename)))
+}
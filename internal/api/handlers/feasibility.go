@@ -0,0 +1,97 @@
+// internal/api/handlers/feasibility.go
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+
+	"tripwand-backend/internal/feasibility"
+	"tripwand-backend/internal/llm"
+	"tripwand-backend/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// MaxRepairDays 생성 시 한 번에 다시 구성하는 최대 일수 (응답 지연 제한)
+const MaxRepairDays = 3
+
+// CheckPlanFeasibility 저장된 계획의 이동 가능성 검사
+// @Summary 일정 이동 가능성 검사
+// @Description 같은 날 연속된 활동 사이의 직선 거리와 이동 수단별 속도로 이동 시간을 추정해, 주어진 시간 안에 이동할 수 없는 구간을 경고로 반환합니다
+// @Tags travel
+// @Produce json
+// @Param id path string true "여행 계획 ID"
+// @Param mode query string false "이동 수단 (walking, transit, car)" default(transit)
+// @Success 200 {object} feasibility.Report "검사 결과"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/feasibility [get]
+func (h *TravelHandler) CheckPlanFeasibility(c *fiber.Ctx) error {
+	mode := c.Query("mode", feasibility.DefaultMode)
+	if _, ok := feasibility.Profiles[mode]; !ok {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "이동 수단은 walking, transit, car 중 하나여야 합니다",
+		})
+	}
+
+	plan, data, err := findExportablePlan(c)
+	if err != nil {
+		return err
+	}
+
+	report := feasibility.Check(data, plan.Destination, h.gazetteer, mode)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    report,
+		"meta": fiber.Map{
+			"plan_id":     plan.ID,
+			"destination": plan.Destination,
+			"feasible":    report.Feasible(),
+		},
+	})
+}
+
+// repairInfeasibleDays 경고가 있는 날을 AI에게 다시 구성하도록 요청하고 재검사한 결과 반환
+// 재생성에 실패한 날은 기존 일정을 유지합니다
+func (h *TravelHandler) repairInfeasibleDays(plan *models.TravelPlans, data *models.TravelResponse, report *feasibility.Report) *feasibility.Report {
+	var repaired []int
+	for _, day := range report.FlaggedDays() {
+		if len(repaired) >= MaxRepairDays {
+			break
+		}
+
+		instructions := report.RepairInstructions(day)
+		req := models.RegenerateRequest{Instructions: &instructions}
+
+		gemmaResp, err := h.gemmaClient.Generate(llm.GenerateRequest{
+			Prompt:      req.ToGemmaPrompt(plan, data, day),
+			Temperature: 0.7,
+			MaxTokens:   1000,
+		})
+		if err != nil {
+			log.Printf("Route repair error (day %d): %v", day, err)
+			continue
+		}
+
+		var result models.RegenerateResult
+		if err := json.Unmarshal([]byte(extractJSON(gemmaResp.GeneratedText)), &result); err != nil {
+			log.Printf("Route repair parse error (day %d): %v", day, err)
+			continue
+		}
+		if err := req.Apply(data, day, &result); err != nil {
+			log.Printf("Route repair apply error (day %d): %v", day, err)
+			continue
+		}
+		repaired = append(repaired, day)
+	}
+
+	if len(repaired) == 0 {
+		return report
+	}
+
+	checked := feasibility.Check(data, plan.Destination, h.gazetteer, report.Mode)
+	checked.RepairedDays = repaired
+	return checked
+}
@@ -0,0 +1,636 @@
+// internal/api/handlers/feedback.go
+package handlers
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"tripwand-backend/internal/database"
+	"tripwand-backend/internal/models"
+	"tripwand-backend/internal/moderation"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// pgUniqueViolation PostgreSQL 고유 제약 위반 오류 코드
+const pgUniqueViolation = "23505"
+
+const (
+	maxCommentLength = 2000
+	maxReviewLength  = 5000
+)
+
+// CommentRequest 댓글 작성/수정 요청
+type CommentRequest struct {
+	Body     string `json:"body" validate:"required" example:"좋은 일정 감사합니다!"`
+	ParentID *uint  `json:"parent_id,omitempty" example:"12"` // 답글 대상 댓글 (작성 시에만 사용)
+}
+
+// ReviewRequest 리뷰 작성/수정 요청
+type ReviewRequest struct {
+	Rating     *int    `json:"rating" validate:"required,min=1,max=5" example:"5"`
+	Body       *string `json:"body,omitempty" example:"일정 그대로 다녀왔는데 동선이 좋았어요"`
+	TraveledAt *string `json:"traveled_at,omitempty" example:"2025-09-14"` // YYYY-MM-DD
+}
+
+// GetPlanComments 여행 계획 댓글 목록
+// @Summary 댓글 목록
+// @Description 최상위 댓글을 오래된 순으로 페이지 단위 조회하며, 각 댓글의 답글을 함께 반환합니다. 삭제된 댓글은 답글이 있으면 내용 없이 자리만 남습니다
+// @Tags feedback
+// @Produce json
+// @Param id path string true "여행 계획 ID"
+// @Param page query int false "페이지 번호" default(1)
+// @Param limit query int false "페이지당 항목 수" default(10)
+// @Success 200 {array} models.PlanComment "댓글 목록"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/comments [get]
+func (h *TravelHandler) GetPlanComments(c *fiber.Ctx) error {
+	userID := currentUserID(c)
+	plan, err := findVisiblePlan(c, userID)
+	if err != nil {
+		return err
+	}
+
+	page, limit, offset := pagination(c)
+
+	// 검수 중이거나 숨겨진 댓글은 작성자에게만 보임
+	var viewerID uint
+	if userID != nil {
+		viewerID = *userID
+	}
+
+	// 삭제된 최상위 댓글은 살아있는 답글이 있을 때만 자리 유지
+	query := database.DB.Unscoped().Model(&models.PlanComment{}).
+		Where("plan_id = ? AND parent_id IS NULL", plan.ID).
+		Where("(moderation_status = ? OR user_id = ?)", models.ModerationApproved, viewerID).
+		Where(`(deleted_at IS NULL OR EXISTS (
+			SELECT 1 FROM plan_comments r WHERE r.parent_id = plan_comments.id AND r.deleted_at IS NULL
+		))`).
+		Session(&gorm.Session{})
+
+	var comments []models.PlanComment
+	var total int64
+
+	query.Count(&total)
+
+	if err := query.Preload("Author").Order("created_at ASC").Offset(offset).Limit(limit).Find(&comments).Error; err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "댓글 조회 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	if len(comments) > 0 {
+		parentIDs := make([]uint, 0, len(comments))
+		for _, comment := range comments {
+			parentIDs = append(parentIDs, comment.ID)
+		}
+
+		var replies []models.PlanComment
+		if err := database.DB.Preload("Author").
+			Where("parent_id IN ?", parentIDs).
+			Where("(moderation_status = ? OR user_id = ?)", models.ModerationApproved, viewerID).
+			Order("created_at ASC").
+			Find(&replies).Error; err != nil {
+			return c.Status(500).JSON(fiber.Map{
+				"success": false,
+				"message": "댓글 조회 중 오류가 발생했습니다",
+				"error":   err.Error(),
+			})
+		}
+
+		repliesByParent := make(map[uint][]models.PlanComment, len(comments))
+		for _, reply := range replies {
+			repliesByParent[*reply.ParentID] = append(repliesByParent[*reply.ParentID], reply)
+		}
+		for i := range comments {
+			comments[i].MaskDeleted()
+			comments[i].Replies = repliesByParent[comments[i].ID]
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    comments,
+		"meta":    paginationMeta(page, limit, total),
+	})
+}
+
+// CreatePlanComment 댓글/답글 작성
+// @Summary 댓글 작성
+// @Description 공개 여행 계획에 댓글을 작성합니다. parent_id를 지정하면 답글이 되며, 답글에 다시 답하면 같은 최상위 댓글 아래에 달립니다
+// @Tags feedback
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Param request body CommentRequest true "댓글 내용"
+// @Success 201 {object} models.PlanComment "작성된 댓글"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Failure 404 {object} map[string]interface{} "계획 또는 부모 댓글을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/comments [post]
+func (h *TravelHandler) CreatePlanComment(c *fiber.Ctx) error {
+	var req CommentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "잘못된 요청 형식입니다",
+			"error":   err.Error(),
+		})
+	}
+
+	body, err := validateCommentBody(req.Body)
+	if err != nil {
+		return err
+	}
+
+	userID := currentUserID(c)
+	plan, err := findVisiblePlan(c, userID)
+	if err != nil {
+		return err
+	}
+
+	comment := models.PlanComment{
+		PlanID:           plan.ID,
+		UserID:           *userID,
+		Body:             body,
+		ModerationStatus: models.ModerationApproved,
+	}
+
+	// 금칙어/개인정보가 있으면 검토 전까지 작성자에게만 보임
+	findings := h.moderator.QuickCheck(body)
+	if len(findings) > 0 {
+		comment.ModerationStatus = models.ModerationPending
+	}
+
+	if req.ParentID != nil {
+		var parent models.PlanComment
+		if err := database.DB.Where("id = ? AND plan_id = ?", *req.ParentID, plan.ID).First(&parent).Error; err != nil {
+			return c.Status(404).JSON(fiber.Map{
+				"success": false,
+				"message": "답글을 달 댓글을 찾을 수 없습니다",
+			})
+		}
+		// 답글은 한 단계까지만 유지
+		if parent.ParentID != nil {
+			comment.ParentID = parent.ParentID
+		} else {
+			comment.ParentID = &parent.ID
+		}
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&comment).Error; err != nil {
+			return err
+		}
+		return models.RefreshPlanCommentCount(tx, plan.ID)
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "댓글 작성 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	h.reviewComment(&comment, findings)
+
+	return c.Status(201).JSON(fiber.Map{
+		"success": true,
+		"data":    comment,
+	})
+}
+
+// UpdatePlanComment 댓글 수정 (작성자 전용)
+// @Summary 댓글 수정
+// @Tags feedback
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param cid path int true "댓글 ID"
+// @Param request body CommentRequest true "수정할 내용"
+// @Success 200 {object} models.PlanComment "수정된 댓글"
+// @Failure 403 {object} map[string]interface{} "권한 없음"
+// @Failure 404 {object} map[string]interface{} "댓글을 찾을 수 없음"
+// @Router /api/v1/travel/comments/{cid} [patch]
+func (h *TravelHandler) UpdatePlanComment(c *fiber.Ctx) error {
+	var req CommentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "잘못된 요청 형식입니다",
+			"error":   err.Error(),
+		})
+	}
+
+	body, err := validateCommentBody(req.Body)
+	if err != nil {
+		return err
+	}
+
+	comment, err := findAuthoredComment(c)
+	if err != nil {
+		return err
+	}
+
+	if err := database.DB.Model(comment).Update("body", body).Error; err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "댓글 수정 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	// 관리자가 숨긴 댓글은 수정해도 숨김 상태 유지
+	if comment.ModerationStatus != models.ModerationHidden {
+		h.reviewComment(comment, h.moderator.QuickCheck(body))
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    comment,
+	})
+}
+
+// DeletePlanComment 댓글 삭제 (작성자 전용, soft delete)
+// @Summary 댓글 삭제
+// @Tags feedback
+// @Produce json
+// @Security BearerAuth
+// @Param cid path int true "댓글 ID"
+// @Success 200 {object} map[string]interface{} "삭제 완료"
+// @Failure 403 {object} map[string]interface{} "권한 없음"
+// @Failure 404 {object} map[string]interface{} "댓글을 찾을 수 없음"
+// @Router /api/v1/travel/comments/{cid} [delete]
+func (h *TravelHandler) DeletePlanComment(c *fiber.Ctx) error {
+	comment, err := findAuthoredComment(c)
+	if err != nil {
+		return err
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(comment).Error; err != nil {
+			return err
+		}
+		return models.RefreshPlanCommentCount(tx, comment.PlanID)
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "댓글 삭제 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "댓글이 삭제되었습니다",
+	})
+}
+
+// GetPlanReviews 여행 계획 리뷰 목록
+// @Summary 리뷰 목록
+// @Description 여행 계획의 "실제로 다녀왔어요" 리뷰를 최신순으로 조회하고 평균 별점을 함께 반환합니다
+// @Tags feedback
+// @Produce json
+// @Param id path string true "여행 계획 ID"
+// @Param page query int false "페이지 번호" default(1)
+// @Param limit query int false "페이지당 항목 수" default(10)
+// @Success 200 {array} models.PlanReview "리뷰 목록"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/reviews [get]
+func (h *TravelHandler) GetPlanReviews(c *fiber.Ctx) error {
+	userID := currentUserID(c)
+	plan, err := findVisiblePlan(c, userID)
+	if err != nil {
+		return err
+	}
+
+	page, limit, offset := pagination(c)
+
+	// 검수 중이거나 숨겨진 리뷰는 작성자에게만 보임
+	var viewerID uint
+	if userID != nil {
+		viewerID = *userID
+	}
+
+	query := database.DB.Model(&models.PlanReview{}).
+		Where("plan_id = ?", plan.ID).
+		Where("(moderation_status = ? OR user_id = ?)", models.ModerationApproved, viewerID).
+		Session(&gorm.Session{})
+
+	var reviews []models.PlanReview
+	var total int64
+
+	query.Count(&total)
+
+	if err := query.Preload("Author").Order("created_at DESC").Offset(offset).Limit(limit).Find(&reviews).Error; err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "리뷰 조회 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	meta := paginationMeta(page, limit, total)
+	meta["rating_avg"] = plan.RatingAvg
+	meta["rating_count"] = plan.RatingCount
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    reviews,
+		"meta":    meta,
+	})
+}
+
+// CreatePlanReview 리뷰 작성 (계획당 1개, 본인 계획 제외)
+// @Summary 리뷰 작성
+// @Description 다녀온 여행 계획에 1~5점 별점 리뷰를 남깁니다. 계획마다 한 번만 작성할 수 있으며, 리뷰 내용은 댓글과 같은 자동 검수를 통과해야 다른 사용자에게 보이고 별점에 반영됩니다
+// @Tags feedback
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Param request body ReviewRequest true "리뷰 내용"
+// @Success 201 {object} models.PlanReview "작성된 리뷰"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Failure 409 {object} map[string]interface{} "이미 리뷰 작성함"
+// @Router /api/v1/travel/plans/{id}/reviews [post]
+func (h *TravelHandler) CreatePlanReview(c *fiber.Ctx) error {
+	var req ReviewRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "잘못된 요청 형식입니다",
+			"error":   err.Error(),
+		})
+	}
+
+	if req.Rating == nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "별점은 필수입니다",
+		})
+	}
+
+	userID := currentUserID(c)
+	plan, err := findVisiblePlan(c, userID)
+	if err != nil {
+		return err
+	}
+
+	if isPlanOwner(plan, userID) {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "본인의 여행 계획에는 리뷰를 남길 수 없습니다",
+		})
+	}
+
+	review := models.PlanReview{
+		PlanID:           plan.ID,
+		UserID:           *userID,
+		ModerationStatus: models.ModerationApproved,
+	}
+	if err := applyReviewRequest(&review, &req); err != nil {
+		return err
+	}
+
+	// 금칙어/개인정보가 있으면 검토 전까지 작성자에게만 보이고 별점에도 반영하지 않음
+	findings := h.moderator.QuickCheck(review.Body)
+	if len(findings) > 0 {
+		review.ModerationStatus = models.ModerationPending
+	}
+
+	var existing int64
+	database.DB.Model(&models.PlanReview{}).Where("plan_id = ? AND user_id = ?", plan.ID, *userID).Count(&existing)
+	if existing > 0 {
+		return c.Status(409).JSON(fiber.Map{
+			"success": false,
+			"message": "이미 이 여행 계획에 리뷰를 남겼습니다",
+		})
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&review).Error; err != nil {
+			return err
+		}
+		return models.RefreshPlanRating(tx, plan.ID)
+	})
+	// 동시에 보낸 요청이 위의 확인을 함께 통과하면 고유 인덱스가 막음
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+		return c.Status(409).JSON(fiber.Map{
+			"success": false,
+			"message": "이미 이 여행 계획에 리뷰를 남겼습니다",
+		})
+	}
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "리뷰 작성 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	h.reviewPlanReview(&review, findings)
+
+	return c.Status(201).JSON(fiber.Map{
+		"success": true,
+		"data":    review,
+	})
+}
+
+// UpdatePlanReview 리뷰 수정 (작성자 전용)
+// @Summary 리뷰 수정
+// @Tags feedback
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param rid path int true "리뷰 ID"
+// @Param request body ReviewRequest true "수정할 내용"
+// @Success 200 {object} models.PlanReview "수정된 리뷰"
+// @Failure 403 {object} map[string]interface{} "권한 없음"
+// @Failure 404 {object} map[string]interface{} "리뷰를 찾을 수 없음"
+// @Router /api/v1/travel/reviews/{rid} [patch]
+func (h *TravelHandler) UpdatePlanReview(c *fiber.Ctx) error {
+	var req ReviewRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "잘못된 요청 형식입니다",
+			"error":   err.Error(),
+		})
+	}
+
+	review, err := findAuthoredReview(c)
+	if err != nil {
+		return err
+	}
+
+	body := review.Body
+	if err := applyReviewRequest(review, &req); err != nil {
+		return err
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(review).Select("rating", "body", "traveled_at").Updates(review).Error; err != nil {
+			return err
+		}
+		return models.RefreshPlanRating(tx, review.PlanID)
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "리뷰 수정 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	// 관리자가 숨긴 리뷰는 수정해도 숨김 상태 유지
+	if review.Body != body && review.ModerationStatus != models.ModerationHidden {
+		h.reviewPlanReview(review, h.moderator.QuickCheck(review.Body))
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    review,
+	})
+}
+
+// DeletePlanReview 리뷰 삭제 (작성자 전용, soft delete)
+// @Summary 리뷰 삭제
+// @Tags feedback
+// @Produce json
+// @Security BearerAuth
+// @Param rid path int true "리뷰 ID"
+// @Success 200 {object} map[string]interface{} "삭제 완료"
+// @Failure 403 {object} map[string]interface{} "권한 없음"
+// @Failure 404 {object} map[string]interface{} "리뷰를 찾을 수 없음"
+// @Router /api/v1/travel/reviews/{rid} [delete]
+func (h *TravelHandler) DeletePlanReview(c *fiber.Ctx) error {
+	review, err := findAuthoredReview(c)
+	if err != nil {
+		return err
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(review).Error; err != nil {
+			return err
+		}
+		return models.RefreshPlanRating(tx, review.PlanID)
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "리뷰 삭제 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "리뷰가 삭제되었습니다",
+	})
+}
+
+// validateCommentBody 댓글 내용 검증 (공백 제거 후 1~2000자)
+func validateCommentBody(body string) (string, error) {
+	trimmed := strings.TrimSpace(body)
+	if trimmed == "" || len([]rune(trimmed)) > maxCommentLength {
+		return "", fiber.NewError(fiber.StatusBadRequest, "댓글은 1자 이상 2000자 이하여야 합니다")
+	}
+	return trimmed, nil
+}
+
+// applyReviewRequest 리뷰 요청 값 검증 후 반영 (보내지 않은 선택 항목은 유지)
+func applyReviewRequest(review *models.PlanReview, req *ReviewRequest) error {
+	if req.Rating != nil {
+		if *req.Rating < 1 || *req.Rating > 5 {
+			return fiber.NewError(fiber.StatusBadRequest, "별점은 1점 이상 5점 이하여야 합니다")
+		}
+		review.Rating = *req.Rating
+	}
+
+	if req.Body != nil {
+		body := strings.TrimSpace(*req.Body)
+		if len([]rune(body)) > maxReviewLength {
+			return fiber.NewError(fiber.StatusBadRequest, "리뷰는 5000자 이하여야 합니다")
+		}
+		review.Body = body
+	}
+
+	if req.TraveledAt != nil {
+		if *req.TraveledAt == "" {
+			review.TraveledAt = nil
+		} else {
+			traveledAt, err := time.Parse("2006-01-02", *req.TraveledAt)
+			if err != nil || traveledAt.After(time.Now()) {
+				return fiber.NewError(fiber.StatusBadRequest, "여행 날짜는 오늘 이전의 YYYY-MM-DD 형식이어야 합니다")
+			}
+			review.TraveledAt = &traveledAt
+		}
+	}
+
+	return nil
+}
+
+// reviewComment 즉시 검사에 걸린 댓글은 검토 큐에 등록하고, 아니면 LLM 분류를 비동기로 실행
+func (h *TravelHandler) reviewComment(comment *models.PlanComment, findings []moderation.Finding) {
+	if len(findings) > 0 {
+		if err := moderation.Flag(database.DB, models.ModerationTargetComment, comment.ID, findings); err != nil {
+			log.Printf("Error flagging comment %d: %v", comment.ID, err)
+		}
+		comment.ModerationStatus = models.ModerationPending
+		return
+	}
+
+	go h.moderator.Review(database.DB, models.ModerationTargetComment, comment.ID, comment.Body)
+}
+
+// reviewPlanReview 즉시 검사에 걸린 리뷰는 검토 큐에 등록하고, 아니면 LLM 분류를 비동기로 실행 (내용 없는 별점은 검사하지 않음)
+func (h *TravelHandler) reviewPlanReview(review *models.PlanReview, findings []moderation.Finding) {
+	if len(findings) > 0 {
+		if err := moderation.Flag(database.DB, models.ModerationTargetReview, review.ID, findings); err != nil {
+			log.Printf("Error flagging review %d: %v", review.ID, err)
+		}
+		review.ModerationStatus = models.ModerationPending
+		return
+	}
+
+	if review.Body != "" {
+		go h.moderator.Review(database.DB, models.ModerationTargetReview, review.ID, review.Body)
+	}
+}
+
+// findAuthoredComment 경로의 :cid 댓글을 조회하고 작성자인지 확인
+func findAuthoredComment(c *fiber.Ctx) (*models.PlanComment, error) {
+	var comment models.PlanComment
+	if err := database.DB.Where("id = ?", c.Params("cid")).First(&comment).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, "댓글을 찾을 수 없습니다")
+	}
+
+	if userID := currentUserID(c); userID == nil || comment.UserID != *userID {
+		return nil, fiber.NewError(fiber.StatusForbidden, "이 댓글에 대한 권한이 없습니다")
+	}
+
+	return &comment, nil
+}
+
+// findAuthoredReview 경로의 :rid 리뷰를 조회하고 작성자인지 확인
+func findAuthoredReview(c *fiber.Ctx) (*models.PlanReview, error) {
+	var review models.PlanReview
+	if err := database.DB.Where("id = ?", c.Params("rid")).First(&review).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, "리뷰를 찾을 수 없습니다")
+	}
+
+	if userID := currentUserID(c); userID == nil || review.UserID != *userID {
+		return nil, fiber.NewError(fiber.StatusForbidden, "이 리뷰에 대한 권한이 없습니다")
+	}
+
+	return &review, nil
+}
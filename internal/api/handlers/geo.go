@@ -0,0 +1,90 @@
+// internal/api/handlers/geo.go
+package handlers
+
+import (
+	"strings"
+
+	"tripwand-backend/internal/geo"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// maxResolveNames 한 번에 좌표를 찾을 수 있는 최대 이름 수
+	maxResolveNames = 50
+	// maxResolveCandidates 이름당 반환할 수 있는 최대 후보 수
+	maxResolveCandidates = 5
+)
+
+// ResolvePlacesRequest 장소 이름 일괄 좌표 검색 요청
+type ResolvePlacesRequest struct {
+	Names       []string `json:"names" validate:"required,min=1,max=50" example:"해운대,Gamcheon Culture Village,gwangalli"`
+	Destination string   `json:"destination,omitempty" example:"부산"`                           // 동명 장소 구분용 여행 목적지
+	Limit       int      `json:"limit,omitempty" validate:"omitempty,min=1,max=5" example:"1"` // 이름당 후보 수 (기본 1)
+}
+
+// ResolvedPlace 이름별 검색 결과
+type ResolvedPlace struct {
+	Query   string      `json:"query"`
+	Matches []geo.Match `json:"matches"`
+}
+
+// ResolvePlaces 장소 이름을 좌표로 일괄 변환
+// @Summary 장소 좌표 일괄 검색
+// @Description 지명 사전에서 한국어/영문/로마자 표기를 비슷한 정도로 비교해 장소 좌표를 찾습니다. destination을 주면 해당 지역의 장소를 우선합니다. 찾지 못한 이름은 matches가 비어 있습니다
+// @Tags geo
+// @Accept json
+// @Produce json
+// @Param request body ResolvePlacesRequest true "검색할 이름 목록"
+// @Success 200 {array} ResolvedPlace "이름별 후보"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Router /api/v1/geo/resolve [post]
+func (h *TravelHandler) ResolvePlaces(c *fiber.Ctx) error {
+	var req ResolvePlacesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "잘못된 요청 형식입니다",
+			"error":   err.Error(),
+		})
+	}
+
+	if len(req.Names) == 0 || len(req.Names) > maxResolveNames {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "names는 1개 이상 50개 이하여야 합니다",
+		})
+	}
+	if req.Limit == 0 {
+		req.Limit = 1
+	}
+	if req.Limit < 1 || req.Limit > maxResolveCandidates {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "limit은 1 이상 5 이하여야 합니다",
+		})
+	}
+
+	results := make([]ResolvedPlace, 0, len(req.Names))
+	resolved := 0
+	for _, name := range req.Names {
+		matches := h.gazetteer.Resolve(strings.TrimSpace(name), req.Destination, req.Limit)
+		if matches == nil {
+			matches = []geo.Match{}
+		}
+		if len(matches) > 0 {
+			resolved++
+		}
+		results = append(results, ResolvedPlace{Query: name, Matches: matches})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    results,
+		"meta": fiber.Map{
+			"requested": len(req.Names),
+			"resolved":  resolved,
+			"places":    h.gazetteer.Len(),
+		},
+	})
+}
@@ -0,0 +1,341 @@
+// internal/api/handlers/moderation.go
+package handlers
+
+import (
+	"strings"
+
+	"tripwand-backend/internal/database"
+	"tripwand-backend/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+const maxReportDetailLength = 1000
+
+// ReportRequest 콘텐츠 신고 요청
+type ReportRequest struct {
+	TargetType string `json:"target_type" validate:"required,oneof=plan comment review" example:"comment"`
+	TargetID   uint   `json:"target_id" validate:"required" example:"42"`
+	Reason     string `json:"reason" validate:"required,oneof=spam abuse personal_info inappropriate other" example:"spam"`
+	Detail     string `json:"detail,omitempty" example:"광고 링크가 반복해서 올라옵니다"`
+}
+
+// ModerationQueueEntry 검토 큐 항목과 대상 콘텐츠
+type ModerationQueueEntry struct {
+	models.ModerationItem
+	Target interface{} `json:"target"` // *models.TravelPlans, *models.PlanComment 또는 *models.PlanReview (영구 삭제됐으면 null)
+}
+
+// ReportContent 여행 계획/댓글/리뷰 신고
+// @Summary 콘텐츠 신고
+// @Description 공개 여행 계획이나 댓글, 리뷰를 신고합니다. 같은 대상은 한 번만 신고되며, 신고가 누적되면 관리자 검토 전까지 숨겨집니다
+// @Tags moderation
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ReportRequest true "신고 내용"
+// @Success 201 {object} map[string]interface{} "신고 접수"
+// @Success 200 {object} map[string]interface{} "이미 신고한 대상"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Failure 404 {object} map[string]interface{} "대상을 찾을 수 없음"
+// @Router /api/v1/travel/reports [post]
+func (h *TravelHandler) ReportContent(c *fiber.Ctx) error {
+	var req ReportRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "잘못된 요청 형식입니다",
+			"error":   err.Error(),
+		})
+	}
+
+	if !models.ModerationTargets[req.TargetType] || req.TargetID == 0 {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "신고 대상은 plan, comment, review 중 하나와 대상 ID로 지정해야 합니다",
+		})
+	}
+	if !models.ReportReasons[req.Reason] {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "신고 사유는 spam, abuse, personal_info, inappropriate, other 중 하나여야 합니다",
+		})
+	}
+	detail := strings.TrimSpace(req.Detail)
+	if len([]rune(detail)) > maxReportDetailLength {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "신고 내용은 1000자 이하여야 합니다",
+		})
+	}
+
+	userID := currentUserID(c)
+	authorID, err := findReportTarget(*userID, req.TargetType, req.TargetID)
+	if err != nil {
+		return err
+	}
+	if authorID != nil && *authorID == *userID {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "본인이 작성한 콘텐츠는 신고할 수 없습니다",
+		})
+	}
+
+	report := models.ContentReport{
+		ReporterID: *userID,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		Reason:     req.Reason,
+		Detail:     detail,
+	}
+	added, err := models.ReportContent(database.DB, &report)
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "신고 처리 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	status := 200
+	if added {
+		status = 201
+	}
+	return c.Status(status).JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"target_type": req.TargetType,
+			"target_id":   req.TargetID,
+			"reported":    true,
+		},
+	})
+}
+
+// GetModerationQueue 검토 큐 조회 (관리자 전용)
+// @Summary 검토 큐 목록
+// @Description 자동 검사나 신고로 등록된 콘텐츠를 대상 내용과 함께 조회합니다. 처리 대기(open) 항목은 신고가 많은 순, 오래된 순으로 정렬됩니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "항목 상태 (open, approved, hidden, deleted)" default(open)
+// @Param page query int false "페이지 번호" default(1)
+// @Param limit query int false "페이지당 항목 수" default(10)
+// @Success 200 {array} ModerationQueueEntry "검토 큐 항목"
+// @Failure 400 {object} map[string]interface{} "잘못된 상태"
+// @Failure 403 {object} map[string]interface{} "관리자 권한 필요"
+// @Router /api/v1/admin/moderation [get]
+func (h *TravelHandler) GetModerationQueue(c *fiber.Ctx) error {
+	status := c.Query("status", models.ModerationItemOpen)
+	if !models.ModerationItemStatuses[status] {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "status는 open, approved, hidden, deleted 중 하나여야 합니다",
+		})
+	}
+
+	page, limit, offset := pagination(c)
+
+	query := database.DB.Model(&models.ModerationItem{}).
+		Where("status = ?", status).
+		Session(&gorm.Session{})
+
+	var items []models.ModerationItem
+	var total int64
+
+	query.Count(&total)
+
+	if err := query.Order("report_count DESC, updated_at ASC").Offset(offset).Limit(limit).Find(&items).Error; err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "검토 큐 조회 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	entries, err := withModerationTargets(items)
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "검토 큐 조회 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    entries,
+		"meta":    paginationMeta(page, limit, total),
+	})
+}
+
+// ApproveModerationItem 검토 항목 승인 - 콘텐츠 노출 (관리자 전용)
+// @Summary 검토 항목 승인
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "검토 항목 ID"
+// @Success 200 {object} models.ModerationItem "처리된 항목"
+// @Failure 403 {object} map[string]interface{} "관리자 권한 필요"
+// @Failure 404 {object} map[string]interface{} "항목을 찾을 수 없음"
+// @Router /api/v1/admin/moderation/{id}/approve [post]
+func (h *TravelHandler) ApproveModerationItem(c *fiber.Ctx) error {
+	return resolveModeration(c, models.ModerationApproved)
+}
+
+// HideModerationItem 검토 항목 숨김 - 작성자에게만 보임 (관리자 전용)
+// @Summary 검토 항목 숨김
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "검토 항목 ID"
+// @Success 200 {object} models.ModerationItem "처리된 항목"
+// @Failure 403 {object} map[string]interface{} "관리자 권한 필요"
+// @Failure 404 {object} map[string]interface{} "항목을 찾을 수 없음"
+// @Router /api/v1/admin/moderation/{id}/hide [post]
+func (h *TravelHandler) HideModerationItem(c *fiber.Ctx) error {
+	return resolveModeration(c, models.ModerationHidden)
+}
+
+// DeleteModerationItem 검토 항목의 대상 콘텐츠 삭제 (관리자 전용)
+// @Summary 검토 대상 삭제
+// @Description 대상 콘텐츠를 삭제합니다. 여행 계획은 소유자의 휴지통으로 이동하며 복원해도 숨김 상태로 남습니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "검토 항목 ID"
+// @Success 200 {object} models.ModerationItem "처리된 항목"
+// @Failure 403 {object} map[string]interface{} "관리자 권한 필요"
+// @Failure 404 {object} map[string]interface{} "항목을 찾을 수 없음"
+// @Router /api/v1/admin/moderation/{id}/delete [post]
+func (h *TravelHandler) DeleteModerationItem(c *fiber.Ctx) error {
+	return resolveModeration(c, models.ModerationItemDeleted)
+}
+
+// resolveModeration 경로의 :id 검토 항목에 관리자 결정 반영
+func resolveModeration(c *fiber.Ctx, status string) error {
+	var item models.ModerationItem
+	if err := database.DB.Where("id = ?", c.Params("id")).First(&item).Error; err != nil {
+		return fiber.NewError(fiber.StatusNotFound, "검토 항목을 찾을 수 없습니다")
+	}
+	if item.Status == models.ModerationItemDeleted {
+		return fiber.NewError(fiber.StatusConflict, "이미 삭제 처리된 항목입니다")
+	}
+
+	if err := models.ResolveModerationItem(database.DB, &item, status, *currentUserID(c)); err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "검토 항목 처리 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    item,
+	})
+}
+
+// findReportTarget 신고 대상이 신고자에게 보이는지 확인하고 작성자 ID 반환
+// 실패 시 *fiber.Error를 반환하며, 앱 ErrorHandler가 응답으로 변환합니다
+func findReportTarget(userID uint, targetType string, targetID uint) (*uint, error) {
+	planID := targetID
+	var authorID *uint
+
+	switch targetType {
+	case models.ModerationTargetComment:
+		var comment models.PlanComment
+		if err := database.DB.Where("id = ?", targetID).First(&comment).Error; err != nil {
+			return nil, fiber.NewError(fiber.StatusNotFound, "댓글을 찾을 수 없습니다")
+		}
+		planID = comment.PlanID
+		authorID = &comment.UserID
+	case models.ModerationTargetReview:
+		var review models.PlanReview
+		if err := database.DB.Where("id = ?", targetID).First(&review).Error; err != nil {
+			return nil, fiber.NewError(fiber.StatusNotFound, "리뷰를 찾을 수 없습니다")
+		}
+		planID = review.PlanID
+		authorID = &review.UserID
+	}
+
+	var plan models.TravelPlans
+	if err := models.VisiblePlansFor(database.DB, userID).Where("id = ?", planID).First(&plan).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, "여행 계획을 찾을 수 없습니다")
+	}
+	if targetType == models.ModerationTargetPlan {
+		authorID = plan.UserID
+	}
+
+	return authorID, nil
+}
+
+// withModerationTargets 검토 항목에 대상 콘텐츠를 붙임 (관리자가 삭제한 대상도 포함)
+func withModerationTargets(items []models.ModerationItem) ([]ModerationQueueEntry, error) {
+	var planIDs, commentIDs, reviewIDs []uint
+	for _, item := range items {
+		switch item.TargetType {
+		case models.ModerationTargetPlan:
+			planIDs = append(planIDs, item.TargetID)
+		case models.ModerationTargetComment:
+			commentIDs = append(commentIDs, item.TargetID)
+		case models.ModerationTargetReview:
+			reviewIDs = append(reviewIDs, item.TargetID)
+		}
+	}
+
+	plans := make(map[uint]*models.TravelPlans, len(planIDs))
+	if len(planIDs) > 0 {
+		var found []models.TravelPlans
+		if err := database.DB.Unscoped().Where("id IN ?", planIDs).Find(&found).Error; err != nil {
+			return nil, err
+		}
+		for i := range found {
+			plans[found[i].ID] = &found[i]
+		}
+	}
+
+	comments := make(map[uint]*models.PlanComment, len(commentIDs))
+	if len(commentIDs) > 0 {
+		var found []models.PlanComment
+		if err := database.DB.Unscoped().Preload("Author").Where("id IN ?", commentIDs).Find(&found).Error; err != nil {
+			return nil, err
+		}
+		for i := range found {
+			comments[found[i].ID] = &found[i]
+		}
+	}
+
+	reviews := make(map[uint]*models.PlanReview, len(reviewIDs))
+	if len(reviewIDs) > 0 {
+		var found []models.PlanReview
+		if err := database.DB.Unscoped().Preload("Author").Where("id IN ?", reviewIDs).Find(&found).Error; err != nil {
+			return nil, err
+		}
+		for i := range found {
+			reviews[found[i].ID] = &found[i]
+		}
+	}
+
+	entries := make([]ModerationQueueEntry, 0, len(items))
+	for _, item := range items {
+		entry := ModerationQueueEntry{ModerationItem: item}
+		switch item.TargetType {
+		case models.ModerationTargetPlan:
+			if plan, ok := plans[item.TargetID]; ok {
+				entry.Target = plan
+			}
+		case models.ModerationTargetComment:
+			if comment, ok := comments[item.TargetID]; ok {
+				entry.Target = comment
+			}
+		case models.ModerationTargetReview:
+			if review, ok := reviews[item.TargetID]; ok {
+				entry.Target = review
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,433 @@
+// internal/api/handlers/plan.go
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"tripwand-backend/internal/database"
+	"tripwand-backend/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// TrashRetention 휴지통 보관 기간 (이후 영구 삭제 작업이 제거)
+var TrashRetention = 30 * 24 * time.Hour
+
+// UpdatePlanRequest 여행 계획 수정 요청 (보내지 않은 필드는 변경하지 않음)
+type UpdatePlanRequest struct {
+	Title    *string                `json:"title,omitempty" example:"가을 부산 여행"`
+	Notes    *string                `json:"notes,omitempty" example:"숙소 예약 완료"`
+	IsPublic *bool                  `json:"is_public,omitempty" example:"false"`
+	PlanData *models.TravelResponse `json:"plan_data,omitempty"`
+	Reason   *string                `json:"reason,omitempty" example:"둘째 날 일정 변경"` // 일정 변경 시 리비전에 남길 사유
+}
+
+// TrashedPlan 휴지통 목록 항목
+type TrashedPlan struct {
+	models.TravelPlans
+	DeletedAt time.Time `json:"deleted_at"`
+	PurgeAt   time.Time `json:"purge_at"`
+}
+
+// UpdatePlan 여행 계획 수정 (소유자 전용)
+// @Summary 여행 계획 수정
+// @Description 제목, 메모, 공개 여부, 편집한 일정을 수정합니다. is_public=false는 공유 링크도 해제합니다. 여러 도시 여행은 일정 일수를 바꿀 수 없습니다. 소유자만 수정할 수 있습니다
+// @Tags travel
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Param request body UpdatePlanRequest true "수정할 항목"
+// @Success 200 {object} models.TravelPlans "수정된 여행 계획"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Failure 403 {object} map[string]interface{} "권한 없음"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id} [patch]
+func (h *TravelHandler) UpdatePlan(c *fiber.Ctx) error {
+	var req UpdatePlanRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "잘못된 요청 형식입니다",
+			"error":   err.Error(),
+		})
+	}
+
+	plan, err := findOwnedPlan(c)
+	if err != nil {
+		return err
+	}
+
+	updates := map[string]interface{}{}
+
+	if req.Title != nil {
+		title := strings.TrimSpace(*req.Title)
+		if title == "" || len([]rune(title)) > 100 {
+			return c.Status(400).JSON(fiber.Map{
+				"success": false,
+				"message": "제목은 1자 이상 100자 이하여야 합니다",
+			})
+		}
+		updates["title"] = title
+	}
+
+	if req.Notes != nil {
+		updates["notes"] = *req.Notes
+	}
+
+	if req.IsPublic != nil {
+		updates["is_public"] = *req.IsPublic
+	}
+	// is_public=false는 비공개 전환이므로 공유 링크도 함께 해제
+	revokeShare := req.IsPublic != nil && !*req.IsPublic && plan.ShareToken != nil
+
+	if req.PlanData != nil {
+		if err := req.PlanData.Validate(); err != nil {
+			return c.Status(400).JSON(fiber.Map{
+				"success": false,
+				"message": "여행 일정 형식이 올바르지 않습니다",
+				"error":   err.Error(),
+			})
+		}
+
+		// 여러 도시 여행은 구간별 일차가 고정되어 있으므로 일수를 바꿀 수 없음
+		if err := database.DB.Where("plan_id = ?", plan.ID).Order("position").Find(&plan.Legs).Error; err != nil {
+			return c.Status(500).JSON(fiber.Map{
+				"success": false,
+				"message": "여행 계획 조회 중 오류가 발생했습니다",
+				"error":   err.Error(),
+			})
+		}
+		if len(plan.Legs) > 0 && len(req.PlanData.Itinerary) != plan.Duration {
+			return c.Status(400).JSON(fiber.Map{
+				"success": false,
+				"message": fmt.Sprintf("여러 도시 여행은 일정 일수(%d일)를 바꿀 수 없습니다", plan.Duration),
+			})
+		}
+
+		// 도시와 날짜 정보는 편집 내용이 아닌 저장된 구간과 시작일 기준으로 다시 기록
+		req.PlanData.AnnotateLegs(plan.TripLegs())
+		if plan.StartDate != nil {
+			req.PlanData.AnnotateDates(*plan.StartDate)
+		}
+
+		planJSON, err := json.Marshal(req.PlanData)
+		if err != nil {
+			return c.Status(500).JSON(fiber.Map{
+				"success": false,
+				"message": "여행 일정 저장 중 오류가 발생했습니다",
+				"error":   err.Error(),
+			})
+		}
+		updates["plan_data"] = string(planJSON)
+		updates["duration"] = len(req.PlanData.Itinerary)
+	}
+
+	if len(updates) == 0 {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "수정할 항목이 없습니다",
+		})
+	}
+
+	previousData := plan.PlanData
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(plan).Updates(updates).Error; err != nil {
+			return err
+		}
+		if revokeShare {
+			if err := models.RevokeShare(tx, plan); err != nil {
+				return err
+			}
+		}
+
+		// 일정이 바뀐 경우 변경 이력 기록
+		if req.PlanData == nil {
+			return nil
+		}
+		_, err := models.RecordPlanRevision(tx, plan, previousData, currentUserID(c), models.RevisionActionEdit, getStringValue(req.Reason))
+		return err
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "여행 계획 수정 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	plan.ResolveVisibility(time.Now())
+
+	// 제목/메모/일정이 바뀌면 다시 검수
+	if req.Title != nil || req.Notes != nil || req.PlanData != nil {
+		h.moderatePlan(plan)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    plan,
+	})
+}
+
+// UpdateVisibilityRequest 공개 여부 변경 요청 (visibility 또는 is_public 중 하나)
+type UpdateVisibilityRequest struct {
+	Visibility *string `json:"visibility,omitempty" validate:"omitempty,oneof=public unlisted private" example:"unlisted"`
+	IsPublic   *bool   `json:"is_public,omitempty" example:"false"`
+}
+
+// UpdatePlanVisibility 여행 계획 공개 여부 변경 (소유자 전용)
+// @Summary 여행 계획 공개 여부 변경
+// @Description 여행 계획의 공개 범위를 변경합니다. unlisted는 목록에서 빠지고 공유 링크로만 볼 수 있으며(링크가 없으면 발급), private은 공유 링크도 해제합니다. visibility 없이 is_public만 보내면 true는 public, false는 private으로 처리합니다. 소유자만 변경할 수 있습니다
+// @Tags travel
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Param request body UpdateVisibilityRequest true "공개 여부"
+// @Success 200 {object} models.TravelPlans "변경된 여행 계획"
+// @Failure 403 {object} map[string]interface{} "권한 없음"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/visibility [patch]
+func (h *TravelHandler) UpdatePlanVisibility(c *fiber.Ctx) error {
+	var req UpdateVisibilityRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "잘못된 요청 형식입니다",
+			"error":   err.Error(),
+		})
+	}
+
+	if req.Visibility == nil && req.IsPublic == nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "visibility 또는 is_public 값은 필수입니다",
+		})
+	}
+	if req.Visibility != nil {
+		switch *req.Visibility {
+		case models.VisibilityPublic, models.VisibilityUnlisted, models.VisibilityPrivate:
+		default:
+			return c.Status(400).JSON(fiber.Map{
+				"success": false,
+				"message": "visibility는 public, unlisted, private 중 하나여야 합니다",
+			})
+		}
+	}
+
+	plan, err := findOwnedPlan(c)
+	if err != nil {
+		return err
+	}
+
+	// is_public만 보낸 경우 true는 public, false는 private으로 처리 (공유 링크도 해제)
+	visibility := models.VisibilityPrivate
+	if req.IsPublic != nil && *req.IsPublic {
+		visibility = models.VisibilityPublic
+	}
+	if req.Visibility != nil {
+		visibility = *req.Visibility
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(plan).Update("is_public", visibility == models.VisibilityPublic).Error; err != nil {
+			return err
+		}
+
+		switch visibility {
+		case models.VisibilityUnlisted:
+			// 이미 유효한 링크가 있으면 그대로 사용
+			if plan.ShareActive(time.Now()) {
+				return nil
+			}
+			return models.EnableShare(tx, plan, nil)
+		case models.VisibilityPrivate:
+			return models.RevokeShare(tx, plan)
+		}
+		return nil
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "공개 여부 변경 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+	plan.ResolveVisibility(time.Now())
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    plan,
+	})
+}
+
+// DeletePlan 여행 계획 삭제 (소유자 전용)
+// @Summary 여행 계획 삭제
+// @Description 여행 계획을 휴지통으로 옮깁니다(soft delete). 보관 기간 내에는 복원할 수 있습니다
+// @Tags travel
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Success 200 {object} map[string]interface{} "삭제 완료"
+// @Failure 403 {object} map[string]interface{} "권한 없음"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id} [delete]
+func (h *TravelHandler) DeletePlan(c *fiber.Ctx) error {
+	plan, err := findOwnedPlan(c)
+	if err != nil {
+		return err
+	}
+
+	if err := database.DB.Delete(plan).Error; err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "여행 계획 삭제 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "여행 계획이 삭제되었습니다",
+	})
+}
+
+// GetTrashedPlans 휴지통 목록 조회
+// @Summary 휴지통 목록
+// @Description 삭제했지만 아직 영구 삭제되지 않은 내 여행 계획을 조회합니다
+// @Tags me
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} TrashedPlan "삭제된 여행 계획 목록"
+// @Failure 401 {object} map[string]interface{} "인증 필요"
+// @Router /api/v1/me/plans/trash [get]
+func (h *TravelHandler) GetTrashedPlans(c *fiber.Ctx) error {
+	userID := currentUserID(c)
+	if userID == nil {
+		return c.Status(401).JSON(fiber.Map{
+			"success": false,
+			"message": "로그인이 필요합니다",
+		})
+	}
+
+	var plans []models.TravelPlans
+	if err := database.DB.Unscoped().
+		Where("user_id = ? AND deleted_at IS NOT NULL", *userID).
+		Order("deleted_at DESC").
+		Find(&plans).Error; err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "휴지통 조회 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	items := make([]TrashedPlan, 0, len(plans))
+	for _, plan := range plans {
+		items = append(items, TrashedPlan{
+			TravelPlans: plan,
+			DeletedAt:   plan.DeletedAt.Time,
+			PurgeAt:     plan.DeletedAt.Time.Add(TrashRetention),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    items,
+		"meta": fiber.Map{
+			"total":          len(items),
+			"retention_days": int(TrashRetention.Hours() / 24),
+		},
+	})
+}
+
+// RestorePlan 휴지통의 여행 계획 복원 (소유자 전용)
+// @Summary 여행 계획 복원
+// @Description 삭제한 여행 계획을 휴지통에서 복원합니다
+// @Tags travel
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Success 200 {object} models.TravelPlans "복원된 여행 계획"
+// @Failure 403 {object} map[string]interface{} "권한 없음"
+// @Failure 404 {object} map[string]interface{} "휴지통에서 계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/restore [post]
+func (h *TravelHandler) RestorePlan(c *fiber.Ctx) error {
+	var plan models.TravelPlans
+	if err := database.DB.Unscoped().
+		Where("id = ? AND deleted_at IS NOT NULL", c.Params("id")).
+		First(&plan).Error; err != nil {
+		return c.Status(404).JSON(fiber.Map{
+			"success": false,
+			"message": "휴지통에서 여행 계획을 찾을 수 없습니다",
+		})
+	}
+
+	if !isPlanOwner(&plan, currentUserID(c)) {
+		return c.Status(403).JSON(fiber.Map{
+			"success": false,
+			"message": "이 여행 계획에 대한 권한이 없습니다",
+		})
+	}
+
+	if err := database.DB.Unscoped().Model(&plan).Update("deleted_at", nil).Error; err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "여행 계획 복원 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    plan,
+	})
+}
+
+// ForkPlan 공개 여행 계획을 내 계정으로 복사
+// @Summary 여행 계획 복사 (fork)
+// @Description 공개된 여행 계획을 내 계정의 비공개 계획으로 복사합니다. 복사본은 원본 ID를 forked_from으로 기록하고 원본의 fork_count가 증가합니다
+// @Tags travel
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "원본 여행 계획 ID"
+// @Success 201 {object} models.TravelPlans "복사된 여행 계획"
+// @Failure 401 {object} map[string]interface{} "인증 필요"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/fork [post]
+func (h *TravelHandler) ForkPlan(c *fiber.Ctx) error {
+	userID := currentUserID(c)
+	if userID == nil {
+		return c.Status(401).JSON(fiber.Map{
+			"success": false,
+			"message": "로그인이 필요합니다",
+		})
+	}
+
+	var source models.TravelPlans
+	if err := database.DB.Where("id = ? AND is_public = ? AND moderation_status = ?", c.Params("id"), true, models.ModerationApproved).
+		First(&source).Error; err != nil {
+		return c.Status(404).JSON(fiber.Map{
+			"success": false,
+			"message": "여행 계획을 찾을 수 없습니다",
+		})
+	}
+
+	fork, err := models.ForkPlan(database.DB, &source, *userID)
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "여행 계획 복사 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(201).JSON(fiber.Map{
+		"success": true,
+		"data":    fork,
+	})
+}
@@ -0,0 +1,151 @@
+// internal/api/handlers/regenerate.go
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+
+	"tripwand-backend/internal/database"
+	"tripwand-backend/internal/llm"
+	"tripwand-backend/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// RegeneratePlanDay 기존 일정의 하루 또는 특정 시간대 재생성 (소유자 전용)
+// @Summary 일정 일부 재생성
+// @Description 나머지 일정을 맥락으로 AI에게 전달해 지정한 일차(또는 시간대)만 새로 생성하고 예상 비용을 다시 계산합니다
+// @Tags travel
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Param day path int true "일차 (1부터 시작)"
+// @Param request body models.RegenerateRequest false "재생성 범위와 추가 요청사항"
+// @Success 200 {object} models.TravelResponse "변경된 여행 일정"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Failure 403 {object} map[string]interface{} "권한 없음"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Failure 500 {object} map[string]interface{} "서버 오류"
+// @Router /api/v1/travel/plans/{id}/days/{day}/regenerate [post]
+func (h *TravelHandler) RegeneratePlanDay(c *fiber.Ctx) error {
+	var req models.RegenerateRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(400).JSON(fiber.Map{
+				"success": false,
+				"message": "잘못된 요청 형식입니다",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	if req.Period != nil && *req.Period != "" {
+		if _, ok := models.PeriodLabels[*req.Period]; !ok {
+			return c.Status(400).JSON(fiber.Map{
+				"success": false,
+				"message": "시간대는 morning, afternoon, evening, night 중 하나여야 합니다",
+			})
+		}
+	}
+
+	plan, err := findOwnedPlan(c)
+	if err != nil {
+		return err
+	}
+
+	current, err := models.ParsePlanData(plan.PlanData)
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "저장된 일정 처리 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	day, err := c.ParamsInt("day")
+	if err != nil || day < 1 || day > len(current.Itinerary) {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "일차가 일정 범위를 벗어났습니다",
+		})
+	}
+
+	prompt := req.ToGemmaPrompt(plan, current, day)
+
+	log.Printf("Regenerating %s of plan %d", req.Scope(day), plan.ID)
+
+	gemmaResp, err := h.gemmaClient.Generate(llm.GenerateRequest{
+		Prompt:      prompt,
+		Temperature: 0.8,
+		MaxTokens:   1000,
+	})
+	if err != nil {
+		log.Printf("Gemma API error: %v", err)
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "AI 서비스 호출 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	var result models.RegenerateResult
+	if err := json.Unmarshal([]byte(extractJSON(gemmaResp.GeneratedText)), &result); err != nil {
+		log.Printf("JSON parse error: %v, raw response: %s", err, gemmaResp.GeneratedText)
+		return c.Status(500).JSON(fiber.Map{
+			"success":      false,
+			"message":      "AI 응답 처리 중 오류가 발생했습니다",
+			"error":        "응답 형식이 올바르지 않습니다",
+			"raw_response": gemmaResp.GeneratedText,
+		})
+	}
+
+	if err := req.Apply(current, day, &result); err != nil {
+		log.Printf("Regenerate apply error: %v, raw response: %s", err, gemmaResp.GeneratedText)
+		return c.Status(500).JSON(fiber.Map{
+			"success":      false,
+			"message":      "AI 응답 처리 중 오류가 발생했습니다",
+			"error":        err.Error(),
+			"raw_response": gemmaResp.GeneratedText,
+		})
+	}
+
+	planJSON, err := json.Marshal(current)
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "여행 일정 저장 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	previousData := plan.PlanData
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(plan).Update("plan_data", string(planJSON)).Error; err != nil {
+			return err
+		}
+		_, err := models.RecordPlanRevision(tx, plan, previousData, currentUserID(c), models.RevisionActionRegenerate, req.Scope(day)+" 재생성")
+		return err
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "여행 일정 저장 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	h.moderatePlan(plan)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    current,
+		"meta": fiber.Map{
+			"plan_id": plan.ID,
+			"day":     day,
+			"period":  getStringValue(req.Period),
+			"model":   gemmaResp.Model,
+		},
+	})
+}
@@ -0,0 +1,255 @@
+// internal/api/handlers/revision.go
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+
+	"tripwand-backend/internal/database"
+	"tripwand-backend/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// RevertPlanRequest 리비전 되돌리기 요청
+type RevertPlanRequest struct {
+	Reason string `json:"reason,omitempty" example:"첫 일정이 더 좋아서 되돌림"`
+}
+
+// GetPlanRevisions 여행 계획 리비전 목록 조회 (소유자 전용)
+// @Summary 여행 계획 리비전 목록
+// @Description 여행 계획 일정의 변경 이력을 최신순으로 조회합니다. 목록에는 일정 본문이 포함되지 않습니다
+// @Tags revisions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Success 200 {array} models.PlanRevision "리비전 목록"
+// @Failure 403 {object} map[string]interface{} "권한 없음"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/revisions [get]
+func (h *TravelHandler) GetPlanRevisions(c *fiber.Ctx) error {
+	plan, err := findOwnedPlan(c)
+	if err != nil {
+		return err
+	}
+
+	var revisions []models.PlanRevision
+	if err := database.DB.Omit("plan_data").
+		Where("plan_id = ?", plan.ID).
+		Order("revision DESC").
+		Find(&revisions).Error; err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "리비전 조회 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    revisions,
+		"meta": fiber.Map{
+			"total": len(revisions),
+		},
+	})
+}
+
+// GetPlanRevision 특정 리비전 조회 (소유자 전용)
+// @Summary 여행 계획 리비전 상세
+// @Description 특정 리비전의 일정 전체를 조회합니다
+// @Tags revisions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Param rev path int true "리비전 번호"
+// @Success 200 {object} models.PlanRevision "리비전 상세"
+// @Failure 403 {object} map[string]interface{} "권한 없음"
+// @Failure 404 {object} map[string]interface{} "리비전을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/revisions/{rev} [get]
+func (h *TravelHandler) GetPlanRevision(c *fiber.Ctx) error {
+	plan, err := findOwnedPlan(c)
+	if err != nil {
+		return err
+	}
+
+	revision, err := findPlanRevision(plan.ID, c.Params("rev"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    revision,
+	})
+}
+
+// DiffPlanRevisions 두 리비전의 일차별 비교 (소유자 전용)
+// @Summary 리비전 비교
+// @Description 두 리비전의 일정을 일차/시간대별로 비교합니다. from을 생략하면 to의 직전 리비전, to를 생략하면 최신 리비전을 사용합니다
+// @Tags revisions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Param from query int false "비교 기준 리비전"
+// @Param to query int false "비교 대상 리비전"
+// @Success 200 {object} models.PlanDiff "비교 결과"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Failure 404 {object} map[string]interface{} "리비전을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/revisions/diff [get]
+func (h *TravelHandler) DiffPlanRevisions(c *fiber.Ctx) error {
+	plan, err := findOwnedPlan(c)
+	if err != nil {
+		return err
+	}
+
+	to := c.QueryInt("to", 0)
+	if to == 0 {
+		if err := database.DB.Model(&models.PlanRevision{}).
+			Where("plan_id = ?", plan.ID).
+			Select("COALESCE(MAX(revision), 0)").
+			Scan(&to).Error; err != nil {
+			return c.Status(500).JSON(fiber.Map{
+				"success": false,
+				"message": "리비전 조회 중 오류가 발생했습니다",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	from := c.QueryInt("from", to-1)
+	if from < 1 || to < 1 || from == to {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "비교할 두 리비전을 지정해주세요",
+		})
+	}
+
+	fromRevision, err := findPlanRevision(plan.ID, strconv.Itoa(from))
+	if err != nil {
+		return err
+	}
+	toRevision, err := findPlanRevision(plan.ID, strconv.Itoa(to))
+	if err != nil {
+		return err
+	}
+
+	before, err := models.ParsePlanData(fromRevision.PlanData)
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "리비전 일정 처리 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+	after, err := models.ParsePlanData(toRevision.PlanData)
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "리비전 일정 처리 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    models.DiffPlans(before, after),
+		"meta": fiber.Map{
+			"from": from,
+			"to":   to,
+		},
+	})
+}
+
+// RevertPlanRevision 이전 리비전으로 되돌리기 (소유자 전용)
+// @Summary 리비전 되돌리기
+// @Description 선택한 리비전의 일정을 현재 일정으로 복원하고, 되돌린 내용을 새 리비전으로 기록합니다
+// @Tags revisions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Param rev path int true "되돌릴 리비전 번호"
+// @Param request body RevertPlanRequest false "되돌리는 사유"
+// @Success 200 {object} models.TravelPlans "되돌린 여행 계획"
+// @Failure 403 {object} map[string]interface{} "권한 없음"
+// @Failure 404 {object} map[string]interface{} "리비전을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/revisions/{rev}/revert [post]
+func (h *TravelHandler) RevertPlanRevision(c *fiber.Ctx) error {
+	var req RevertPlanRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(400).JSON(fiber.Map{
+				"success": false,
+				"message": "잘못된 요청 형식입니다",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	plan, err := findOwnedPlan(c)
+	if err != nil {
+		return err
+	}
+
+	revision, err := findPlanRevision(plan.ID, c.Params("rev"))
+	if err != nil {
+		return err
+	}
+
+	target, err := models.ParsePlanData(revision.PlanData)
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "리비전 일정 처리 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = fmt.Sprintf("리비전 %d(으)로 되돌림", revision.Revision)
+	}
+
+	previousData := plan.PlanData
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(plan).Updates(map[string]interface{}{
+			"plan_data": revision.PlanData,
+			"duration":  len(target.Itinerary),
+		}).Error; err != nil {
+			return err
+		}
+		_, err := models.RecordPlanRevision(tx, plan, previousData, currentUserID(c), models.RevisionActionRevert, reason)
+		return err
+	})
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "리비전 되돌리기 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	h.moderatePlan(plan)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    plan,
+	})
+}
+
+// findPlanRevision 계획의 특정 번호 리비전 조회
+// 실패 시 *fiber.Error를 반환하며, 앱 ErrorHandler가 응답으로 변환합니다
+func findPlanRevision(planID uint, rev string) (*models.PlanRevision, error) {
+	number, err := strconv.Atoi(rev)
+	if err != nil || number < 1 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "리비전 번호가 올바르지 않습니다")
+	}
+
+	var revision models.PlanRevision
+	if err := database.DB.Where("plan_id = ? AND revision = ?", planID, number).First(&revision).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, "리비전을 찾을 수 없습니다")
+	}
+
+	return &revision, nil
+}
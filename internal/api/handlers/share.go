@@ -0,0 +1,197 @@
+// internal/api/handlers/share.go
+package handlers
+
+import (
+	"strings"
+	"time"
+
+	"tripwand-backend/internal/database"
+	"tripwand-backend/internal/models"
+	"tripwand-backend/internal/views"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ShareBaseURL 공유 링크 앞부분 (예: https://tripwand.app/share) - 비어 있으면 API 주소 사용
+var ShareBaseURL = ""
+
+// maxShareDays 공유 링크 최대 유효 기간 (일)
+const maxShareDays = 365
+
+// ShareRequest 공유 링크 발급 요청
+type ShareRequest struct {
+	ExpiresInDays *int `json:"expires_in_days,omitempty" validate:"omitempty,min=1,max=365" example:"7"` // 생략하면 만료 없음
+}
+
+// GetPlanShare 공유 링크 조회 (소유자 전용)
+// @Summary 공유 링크 조회
+// @Tags share
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Success 200 {object} map[string]interface{} "공유 링크 정보"
+// @Failure 403 {object} map[string]interface{} "권한 없음"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/share [get]
+func (h *TravelHandler) GetPlanShare(c *fiber.Ctx) error {
+	plan, err := findOwnedPlan(c)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    shareInfo(c, plan),
+	})
+}
+
+// CreatePlanShare 공유 링크 발급/재발급 (소유자 전용)
+// @Summary 공유 링크 발급
+// @Description 목록에 노출되지 않고 링크를 아는 사람만 볼 수 있는 공유 링크를 발급합니다. 다시 호출하면 새 링크가 발급되고 이전 링크는 무효화됩니다
+// @Tags share
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Param request body ShareRequest false "유효 기간"
+// @Success 201 {object} map[string]interface{} "공유 링크 정보"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Failure 403 {object} map[string]interface{} "권한 없음"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/share [post]
+func (h *TravelHandler) CreatePlanShare(c *fiber.Ctx) error {
+	var req ShareRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(400).JSON(fiber.Map{
+				"success": false,
+				"message": "잘못된 요청 형식입니다",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresInDays != nil {
+		if *req.ExpiresInDays < 1 || *req.ExpiresInDays > maxShareDays {
+			return c.Status(400).JSON(fiber.Map{
+				"success": false,
+				"message": "expires_in_days는 1 이상 365 이하여야 합니다",
+			})
+		}
+		expiry := time.Now().Add(time.Duration(*req.ExpiresInDays) * 24 * time.Hour)
+		expiresAt = &expiry
+	}
+
+	plan, err := findOwnedPlan(c)
+	if err != nil {
+		return err
+	}
+
+	if err := models.EnableShare(database.DB, plan, expiresAt); err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "공유 링크 발급 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(201).JSON(fiber.Map{
+		"success": true,
+		"data":    shareInfo(c, plan),
+	})
+}
+
+// RevokePlanShare 공유 링크 해제 (소유자 전용)
+// @Summary 공유 링크 해제
+// @Tags share
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "여행 계획 ID"
+// @Success 200 {object} map[string]interface{} "해제 완료"
+// @Failure 403 {object} map[string]interface{} "권한 없음"
+// @Failure 404 {object} map[string]interface{} "계획을 찾을 수 없음"
+// @Router /api/v1/travel/plans/{id}/share [delete]
+func (h *TravelHandler) RevokePlanShare(c *fiber.Ctx) error {
+	plan, err := findOwnedPlan(c)
+	if err != nil {
+		return err
+	}
+
+	if err := models.RevokeShare(database.DB, plan); err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "공유 링크 해제 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    shareInfo(c, plan),
+	})
+}
+
+// GetSharedPlan 공유 링크로 여행 계획 조회
+// @Summary 공유된 여행 계획 조회
+// @Description 공유 토큰으로 여행 계획을 조회합니다. 로그인이 필요 없으며 링크 미리보기용 Open Graph 메타데이터를 함께 반환합니다
+// @Tags share
+// @Produce json
+// @Param token path string true "공유 토큰"
+// @Success 200 {object} models.TravelPlans "여행 계획"
+// @Failure 404 {object} map[string]interface{} "링크가 없거나 만료됨"
+// @Router /api/v1/share/{token} [get]
+func (h *TravelHandler) GetSharedPlan(c *fiber.Ctx) error {
+	plan, err := models.FindSharedPlan(database.DB, c.Params("token"), time.Now())
+	if err != nil {
+		return c.Status(404).JSON(fiber.Map{
+			"success": false,
+			"message": "공유 링크가 없거나 만료되었습니다",
+		})
+	}
+
+	// 공유 링크 조회도 일반 조회와 같은 기준으로 집계
+	userAgent := c.Get(fiber.HeaderUserAgent)
+	if !views.IsBot(userAgent) {
+		views.DefaultCounter.Record(plan.ID, views.ViewerKey(nil, clientIP(c), userAgent), time.Now())
+	}
+
+	meta := fiber.Map{
+		"og": plan.OpenGraph(),
+	}
+	if code := c.Query("currency"); code != "" {
+		conversion, err := planConversion(plan, code)
+		if err != nil {
+			return err
+		}
+		meta["conversion"] = conversion
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    plan,
+		"meta":    meta,
+	})
+}
+
+// shareInfo 소유자에게 반환하는 공유 링크 정보
+func shareInfo(c *fiber.Ctx, plan *models.TravelPlans) fiber.Map {
+	info := fiber.Map{
+		"visibility": plan.Visibility,
+		"active":     plan.ShareActive(time.Now()),
+		"expires_at": plan.ShareExpiresAt,
+		"token":      nil,
+		"url":        nil,
+	}
+
+	if plan.ShareToken != nil {
+		baseURL := ShareBaseURL
+		if baseURL == "" {
+			baseURL = c.BaseURL() + "/api/v1/share"
+		}
+		info["token"] = *plan.ShareToken
+		info["url"] = strings.TrimSuffix(baseURL, "/") + "/" + *plan.ShareToken
+	}
+
+	return info
+}
@@ -3,25 +3,39 @@ package handlers
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"tripwand-backend/internal/api/middleware"
+	"tripwand-backend/internal/constraints"
+	"tripwand-backend/internal/currency"
 	"tripwand-backend/internal/database"
+	"tripwand-backend/internal/feasibility"
+	"tripwand-backend/internal/geo"
 	"tripwand-backend/internal/llm"
 	"tripwand-backend/internal/models"
+	"tripwand-backend/internal/moderation"
+	"tripwand-backend/internal/views"
 
 	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
 )
 
 // TravelHandler 여행 관련 핸들러
 type TravelHandler struct {
 	gemmaClient *llm.GemmaClient
+	moderator   *moderation.Service
+	gazetteer   *geo.Gazetteer
 }
 
 // NewTravelHandler 새로운 여행 핸들러 생성
 func NewTravelHandler(gemmaClient *llm.GemmaClient) *TravelHandler {
 	return &TravelHandler{
 		gemmaClient: gemmaClient,
+		moderator:   moderation.NewService(gemmaClient),
+		gazetteer:   geo.Default(),
 	}
 }
 
@@ -48,6 +62,24 @@ func (h *TravelHandler) GenerateItinerary(c *fiber.Ctx) error {
 		})
 	}
 
+	// 여러 도시 여행이면 구간으로 목적지와 기간 계산
+	if err := req.NormalizeLegs(); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": fmt.Sprintf("여행 구간이 올바르지 않습니다 (최대 %d개 도시, 도시별 1박 이상, 기간은 전체 박 수 + 1일)", models.MaxTripLegs),
+			"error":   err.Error(),
+		})
+	}
+
+	// 여행자 제약 조건 정리 (허용된 값만)
+	if err := req.Constraints.Normalize(); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "지원하지 않는 여행자 조건입니다",
+			"error":   err.Error(),
+		})
+	}
+
 	// 필수값 검증
 	if req.Destination == "" {
 		return c.Status(400).JSON(fiber.Map{
@@ -63,6 +95,56 @@ func (h *TravelHandler) GenerateItinerary(c *fiber.Ctx) error {
 		})
 	}
 
+	schedule, err := req.Schedule()
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "일정 선호 조건이 올바르지 않습니다 (pace는 relaxed, balanced, packed 중 하나, 시각은 HH:MM, 휴식일은 여행 기간 안의 일차)",
+			"error":   err.Error(),
+		})
+	}
+
+	startDate, err := req.TripStart()
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "여행 날짜가 올바르지 않습니다 (YYYY-MM-DD 형식, 종료일까지의 일수는 여행 기간과 같아야 합니다)",
+			"error":   err.Error(),
+		})
+	}
+
+	transportMode := getStringValue(req.TransportMode)
+	if transportMode == "" {
+		transportMode = feasibility.DefaultMode
+	}
+	if _, ok := feasibility.Profiles[transportMode]; !ok {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "이동 수단은 walking, transit, car 중 하나여야 합니다",
+		})
+	}
+
+	if req.Budget != nil && *req.Budget <= 0 {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "예산은 0보다 커야 합니다",
+		})
+	}
+	if !models.BudgetModes[req.BudgetModeOrDefault()] {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "예산 모드는 target 또는 stay_under여야 합니다",
+		})
+	}
+
+	displayCurrency := strings.ToUpper(getStringValue(req.Currency))
+	if _, ok := currency.Default().Rate(displayCurrency); displayCurrency != "" && !ok {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "지원하지 않는 통화입니다",
+		})
+	}
+
 	// Gemma 프롬프트 생성
 	prompt := req.ToGemmaPrompt()
 
@@ -108,18 +190,102 @@ func (h *TravelHandler) GenerateItinerary(c *fiber.Ctx) error {
 		travelResponse.Itinerary = adjustItineraryDays(travelResponse.Itinerary, req.Duration)
 	}
 
+	// 일차별로 머무는 도시와 도시 간 이동일 기록
+	travelResponse.AnnotateLegs(req.Legs)
+
+	// 예산 내역 합계를 항목 기준으로 다시 계산하고, stay_under 모드에서 예산을 넘으면 한 번 더 생성
+	budgetLimit := getIntValue(req.Budget)
+	travelResponse.Reconcile(getIntValue(req.GroupSize), budgetLimit)
+	budgetRetried := false
+	if req.BudgetModeOrDefault() == models.BudgetModeStayUnder && budgetLimit > 0 && travelResponse.EstimatedCost > budgetLimit {
+		retried := h.generateWithinBudget(req, prompt+models.BudgetRetryPrompt(travelResponse.EstimatedCost, budgetLimit))
+		if retried != nil && retried.EstimatedCost < travelResponse.EstimatedCost {
+			travelResponse = *retried
+			budgetRetried = true
+		}
+	}
+
+	// 날짜를 지정했으면 일차별 날짜/요일/공휴일과 여행 시기의 평년 기후 기록
+	// 기후 값은 AI 응답 대신 내장 평년값을 사용하고, 실내 대안만 AI 응답에서 가져옴
+	generatedClimate := travelResponse.Climate
+	travelResponse.Climate = nil
+	if startDate != nil {
+		travelResponse.AnnotateDates(*startDate)
+		if summary := req.ClimateSummary(*startDate); summary != nil {
+			travelResponse.Climate = summary.WithAlternatives(generatedClimate)
+		}
+	}
+
+	// 같은 날 이동이 불가능한 구간 검사 (요청 시 해당 날을 다시 구성)
+	report := feasibility.Check(&travelResponse, req.Destination, h.gazetteer, transportMode)
+	if !report.Feasible() && req.RepairRoutes != nil && *req.RepairRoutes {
+		report = h.repairInfeasibleDays(requestPlan(req), &travelResponse, report)
+	}
+
+	// 여행자 제약 조건에 맞지 않을 수 있는 활동 표시
+	constraintReport := constraints.Check(&travelResponse, req.Constraints.Tags())
+
+	meta := fiber.Map{
+		"destination": req.Destination,
+		"duration":    req.Duration,
+		"model":       "gemma-3-27b-it",
+		"feasibility": report,
+		"start_date":  getStringValue(req.StartDate),
+	}
+	if conversion, err := convertCosts(displayCurrency, &travelResponse); err == nil && conversion != nil {
+		meta["conversion"] = conversion
+	}
+	if len(constraintReport.Constraints) > 0 {
+		meta["constraints"] = constraintReport
+	}
+	// 일차별 활동 수와 활동 시각이 속도/시간 선호 안에 있는지 표시
+	if schedule != nil {
+		meta["schedule"] = travelResponse.CheckSchedule(schedule)
+	}
+	if budgetLimit > 0 {
+		meta["budget"] = fiber.Map{
+			"limit":         budgetLimit,
+			"mode":          req.BudgetModeOrDefault(),
+			"within_budget": travelResponse.EstimatedCost <= budgetLimit,
+			"retried":       budgetRetried,
+		}
+	}
+
 	// 데이터베이스에 저장 (선택사항)
-	go h.saveTravelPlan(req, travelResponse)
+	// 로그인 사용자는 소유자로, 비회원은 게스트 ID로 기록 (Locals는 핸들러 반환 후 재사용되므로 미리 추출)
+	go h.saveTravelPlan(req, travelResponse, currentUserID(c), currentGuestID(c))
 
 	return c.JSON(fiber.Map{
 		"success": true,
 		"data":    travelResponse,
-		"meta": fiber.Map{
-			"destination": req.Destination,
-			"duration":    req.Duration,
-			"model":       "gemma-3-27b-it",
-		},
+		"meta":    meta,
+	})
+}
+
+// generateWithinBudget 예산을 넘은 일정을 예산 안내를 덧붙여 다시 생성 (실패하면 nil)
+func (h *TravelHandler) generateWithinBudget(req models.TravelRequest, prompt string) *models.TravelResponse {
+	log.Printf("Regenerating itinerary for %s within budget %d", req.Destination, getIntValue(req.Budget))
+
+	gemmaResp, err := h.gemmaClient.Generate(llm.GenerateRequest{
+		Prompt:      prompt,
+		Temperature: 0.7,
+		MaxTokens:   2000,
 	})
+	if err != nil {
+		log.Printf("Gemma API error (budget retry): %v", err)
+		return nil
+	}
+
+	var travelResponse models.TravelResponse
+	if err := json.Unmarshal([]byte(extractJSON(gemmaResp.GeneratedText)), &travelResponse); err != nil {
+		log.Printf("JSON parse error (budget retry): %v", err)
+		return nil
+	}
+
+	travelResponse.Itinerary = adjustItineraryDays(travelResponse.Itinerary, req.Duration)
+	travelResponse.AnnotateLegs(req.Legs)
+	travelResponse.Reconcile(getIntValue(req.GroupSize), getIntValue(req.Budget))
+	return &travelResponse
 }
 
 // GetSavedPlans 저장된 여행 계획 목록 조회
@@ -129,13 +295,17 @@ func (h *TravelHandler) GenerateItinerary(c *fiber.Ctx) error {
 // @Produce json
 // @Param page query int false "페이지 번호" default(1)
 // @Param limit query int false "페이지당 항목 수" default(10)
-// @Param destination query string false "목적지 필터"
+// @Param destination query string false "목적지 필터 (여러 도시 여행은 어느 구간이든 일치하면 포함)"
+// @Param constraints query string false "여행자 조건 필터 (쉼표 구분, 모두 만족하는 계획만: wheelchair, stroller, limited_walking, vegetarian, vegan, halal, gluten_free, kids, pets)"
+// @Param sort query string false "정렬 기준 (recent, popular, trending, rating)" default(recent)
 // @Success 200 {array} models.TravelPlan "여행 계획 목록"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
 // @Router /api/v1/travel/plans [get]
 func (h *TravelHandler) GetSavedPlans(c *fiber.Ctx) error {
 	page := c.QueryInt("page", 1)
 	limit := c.QueryInt("limit", 10)
 	destination := c.Query("destination", "")
+	sort := c.Query("sort", "recent")
 
 	if page < 1 {
 		page = 1
@@ -143,13 +313,34 @@ func (h *TravelHandler) GetSavedPlans(c *fiber.Ctx) error {
 	if limit < 1 || limit > 50 {
 		limit = 10
 	}
+	order, ok := models.PlanSortOrders[sort]
+	if !ok {
+		sort = "recent"
+		order = models.PlanSortOrders[sort]
+	}
+	constraintTags, err := models.ParseConstraintTags(c.Query("constraints"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "지원하지 않는 여행자 조건입니다",
+			"error":   err.Error(),
+		})
+	}
 
 	offset := (page - 1) * limit
 
-	query := database.DB.Where("is_public = ?", true)
+	query := database.DB.Where("is_public = ? AND moderation_status = ?", true, models.ModerationApproved)
 
+	// 여러 도시 여행은 어느 구간의 목적지와 일치해도 포함
 	if destination != "" {
-		query = query.Where("destination ILIKE ?", "%"+destination+"%")
+		pattern := "%" + destination + "%"
+		query = query.Where("(destination ILIKE ? OR EXISTS (SELECT 1 FROM plan_legs l WHERE l.plan_id = travel_plans.id AND l.destination ILIKE ?))",
+			pattern, pattern)
+	}
+
+	// 요청한 여행자 조건을 모두 포함한 계획만 (태그는 쉼표로 이어 저장됨)
+	for _, tag := range constraintTags {
+		query = query.Where("(',' || constraints || ',') LIKE ?", "%,"+tag+",%")
 	}
 
 	var plans []models.TravelPlans
@@ -159,7 +350,7 @@ func (h *TravelHandler) GetSavedPlans(c *fiber.Ctx) error {
 	query.Model(&models.TravelPlans{}).Count(&total)
 
 	// 페이징된 결과 조회
-	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&plans).Error; err != nil {
+	if err := query.Preload("Legs", orderLegs).Order(order).Offset(offset).Limit(limit).Find(&plans).Error; err != nil {
 		return c.Status(500).JSON(fiber.Map{
 			"success": false,
 			"message": "여행 계획 조회 중 오류가 발생했습니다",
@@ -173,6 +364,7 @@ func (h *TravelHandler) GetSavedPlans(c *fiber.Ctx) error {
 		"meta": fiber.Map{
 			"page":        page,
 			"limit":       limit,
+			"sort":        sort,
 			"total":       total,
 			"total_pages": (total + int64(limit) - 1) / int64(limit),
 		},
@@ -192,44 +384,274 @@ func (h *TravelHandler) GetPlanByID(c *fiber.Ctx) error {
 	planID := c.Params("id")
 
 	var plan models.TravelPlans
-	if err := database.DB.Where("id = ? AND is_public = ?", planID, true).First(&plan).Error; err != nil {
+	if err := database.DB.Preload("Legs", orderLegs).Where("id = ?", planID).First(&plan).Error; err != nil {
+		return c.Status(404).JSON(fiber.Map{
+			"success": false,
+			"message": "여행 계획을 찾을 수 없습니다",
+		})
+	}
+
+	// 비공개 계획과 검수를 통과하지 못한 계획은 소유자만 조회 가능
+	if (!plan.IsPublic || plan.ModerationStatus != models.ModerationApproved) && !isPlanOwner(&plan, currentUserID(c)) {
 		return c.Status(404).JSON(fiber.Map{
 			"success": false,
 			"message": "여행 계획을 찾을 수 없습니다",
 		})
 	}
 
-	// 조회수 증가
-	database.DB.Model(&plan).Update("view_count", plan.ViewCount+1)
+	// 조회수 증가 (봇/소유자 제외, 같은 조회자는 일정 시간 내 1회만 집계, 주기적으로 일괄 반영)
+	userID := currentUserID(c)
+	userAgent := c.Get(fiber.HeaderUserAgent)
+	if !views.IsBot(userAgent) && !isPlanOwner(&plan, userID) {
+		views.DefaultCounter.Record(plan.ID, views.ViewerKey(userID, clientIP(c), userAgent), time.Now())
+	}
+
+	meta := fiber.Map{}
+	if userID != nil {
+		var liked, bookmarked int64
+		database.DB.Model(&models.PlanLike{}).Where("user_id = ? AND plan_id = ?", *userID, plan.ID).Count(&liked)
+		database.DB.Model(&models.PlanBookmark{}).Where("user_id = ? AND plan_id = ?", *userID, plan.ID).Count(&bookmarked)
+		meta["liked"] = liked > 0
+		meta["bookmarked"] = bookmarked > 0
+	}
+
+	// 요청한 통화로 비용 환산
+	if code := c.Query("currency"); code != "" {
+		conversion, err := planConversion(&plan, code)
+		if err != nil {
+			return err
+		}
+		meta["conversion"] = conversion
+	}
 
 	return c.JSON(fiber.Map{
 		"success": true,
 		"data":    plan,
+		"meta":    meta,
+	})
+}
+
+// GetMyPlans 내 여행 계획 목록 조회
+// @Summary 내 여행 계획 목록
+// @Description 로그인한 사용자가 생성한 여행 계획을 비공개 계획까지 포함해 조회합니다
+// @Tags me
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "페이지 번호" default(1)
+// @Param limit query int false "페이지당 항목 수" default(10)
+// @Success 200 {array} models.TravelPlans "여행 계획 목록"
+// @Failure 401 {object} map[string]interface{} "인증 필요"
+// @Router /api/v1/me/plans [get]
+func (h *TravelHandler) GetMyPlans(c *fiber.Ctx) error {
+	userID := currentUserID(c)
+	if userID == nil {
+		return c.Status(401).JSON(fiber.Map{
+			"success": false,
+			"message": "로그인이 필요합니다",
+		})
+	}
+
+	page, limit, offset := pagination(c)
+
+	query := database.DB.Where("user_id = ?", *userID)
+
+	var plans []models.TravelPlans
+	var total int64
+
+	// 전체 개수 조회
+	query.Model(&models.TravelPlans{}).Count(&total)
+
+	// 페이징된 결과 조회
+	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&plans).Error; err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "여행 계획 조회 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    plans,
+		"meta":    paginationMeta(page, limit, total),
+	})
+}
+
+// ClaimGuestPlans 비회원으로 생성한 여행 계획을 내 계정으로 이전
+// @Summary 게스트 여행 계획 이전
+// @Description 로그인 전 게스트 토큰(쿠키 또는 X-Guest-Token 헤더)으로 생성한 여행 계획을 로그인한 사용자 계정으로 이전합니다
+// @Tags me
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "이전된 계획 수"
+// @Failure 400 {object} map[string]interface{} "게스트 토큰 없음"
+// @Failure 401 {object} map[string]interface{} "인증 필요"
+// @Router /api/v1/me/claim-guest-plans [post]
+func (h *TravelHandler) ClaimGuestPlans(c *fiber.Ctx) error {
+	userID := currentUserID(c)
+	if userID == nil {
+		return c.Status(401).JSON(fiber.Map{
+			"success": false,
+			"message": "로그인이 필요합니다",
+		})
+	}
+
+	guestID := middleware.ExtractGuestID(c)
+	if guestID == "" {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "유효한 게스트 토큰이 없습니다",
+		})
+	}
+
+	claimed, err := models.ClaimGuestPlans(database.DB, guestID, *userID)
+	if err != nil {
+		log.Printf("Error claiming guest plans: %v", err)
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "게스트 여행 계획 이전 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	// 이전이 끝난 게스트 식별자는 더 이상 사용하지 않음
+	middleware.ClearGuestCookie(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"claimed_count": claimed,
+		},
 	})
 }
 
 // saveTravelPlan 여행 계획을 데이터베이스에 저장 (비동기)
-func (h *TravelHandler) saveTravelPlan(req models.TravelRequest, resp models.TravelResponse) {
+func (h *TravelHandler) saveTravelPlan(req models.TravelRequest, resp models.TravelResponse, userID *uint, guestID string) {
 	planJSON, err := json.Marshal(resp)
 	if err != nil {
 		log.Printf("Error marshaling travel plan: %v", err)
 		return
 	}
 
-	plan := models.TravelPlans{
+	plan := requestPlan(req)
+	plan.UserID = userID
+	plan.PlanData = string(planJSON)
+	plan.IsPublic = true // 기본적으로 공개
+	// 자동 검수를 통과할 때까지 다른 사용자에게 노출하지 않음
+	plan.ModerationStatus = models.ModerationPending
+
+	// 비회원 계획은 로그인 후 이전할 수 있도록 게스트 ID 기록
+	if userID == nil {
+		plan.GuestID = guestID
+	}
+
+	// 계획 저장과 첫 리비전 기록을 함께 처리
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(plan).Error; err != nil {
+			return err
+		}
+		_, err := models.RecordPlanRevision(tx, plan, "", userID, models.RevisionActionGenerate, "")
+		return err
+	})
+	if err != nil {
+		log.Printf("Error saving travel plan: %v", err)
+		return
+	}
+
+	h.moderator.Review(database.DB, models.ModerationTargetPlan, plan.ID, moderation.PlanText(plan))
+}
+
+// orderLegs 구간을 방문 순서대로 불러오는 Preload 조건
+func orderLegs(db *gorm.DB) *gorm.DB {
+	return db.Order("position")
+}
+
+// requestPlan 생성 요청 정보로 채운 여행 계획 (저장 전, 재구성 프롬프트의 맥락으로도 사용)
+func requestPlan(req models.TravelRequest) *models.TravelPlans {
+	plan := &models.TravelPlans{
+		Title:       models.DefaultPlanTitle(req.Destination, req.Duration),
 		Destination: req.Destination,
 		Duration:    req.Duration,
+		Language:    models.LanguageOrDefault(req.Language),
 		AgeGroup:    getStringValue(req.AgeGroup),
 		GroupSize:   getIntValue(req.GroupSize),
 		Purpose:     getStringValue(req.Purpose),
 		TravelType:  getStringValue(req.TravelType),
-		PlanData:    string(planJSON),
-		IsPublic:    true, // 기본적으로 공개
+		Legs:        models.NewPlanLegs(req.Legs),
+		Constraints: req.Constraints.Tags(),
 	}
+	// 날짜는 GenerateItinerary에서 이미 검증됨
+	if start, err := req.TripStart(); err == nil {
+		plan.StartDate = start
+	}
+	return plan
+}
 
-	if err := database.DB.Create(&plan).Error; err != nil {
-		log.Printf("Error saving travel plan: %v", err)
+// moderatePlan 내용이 바뀐 계획을 검수 전까지 숨기고 비동기로 다시 검사
+// 관리자가 숨긴 계획은 수정해도 숨김 상태를 유지합니다
+func (h *TravelHandler) moderatePlan(plan *models.TravelPlans) {
+	if plan.ModerationStatus == models.ModerationHidden {
+		return
+	}
+
+	if err := models.SetModerationStatus(database.DB, models.ModerationTargetPlan, plan.ID, models.ModerationPending); err != nil {
+		log.Printf("Error marking plan %d for moderation: %v", plan.ID, err)
+		return
+	}
+	plan.ModerationStatus = models.ModerationPending
+
+	go h.moderator.Review(database.DB, models.ModerationTargetPlan, plan.ID, moderation.PlanText(plan))
+}
+
+// currentUserID 인증 미들웨어가 저장한 사용자 ID 조회 (비회원이면 nil)
+func currentUserID(c *fiber.Ctx) *uint {
+	if userID, ok := c.Locals("user_id").(uint); ok && userID != 0 {
+		return &userID
+	}
+	return nil
+}
+
+// currentGuestID 게스트 미들웨어가 저장한 게스트 ID 조회 (없으면 "")
+func currentGuestID(c *fiber.Ctx) string {
+	if guestID, ok := c.Locals("guest_id").(string); ok {
+		return guestID
+	}
+	return ""
+}
+
+// TrustedProxyHops 요청 앞단에서 X-Forwarded-For에 주소를 덧붙이는 신뢰 프록시 수 (Cloud Run 1, 직접 받으면 0)
+var TrustedProxyHops = 1
+
+// clientIP 요청자 IP
+// X-Forwarded-For의 앞쪽 항목은 클라이언트가 임의로 넣을 수 있으므로, 신뢰 프록시가 덧붙인 오른쪽 끝에서
+// TrustedProxyHops번째 주소를 사용합니다. 항목이 그보다 적으면 프록시를 거치지 않은 요청으로 보고 연결 주소를 사용합니다
+func clientIP(c *fiber.Ctx) string {
+	if TrustedProxyHops > 0 {
+		if ips := c.IPs(); len(ips) >= TrustedProxyHops {
+			return ips[len(ips)-TrustedProxyHops]
+		}
 	}
+	return c.IP()
+}
+
+// isPlanOwner 사용자가 여행 계획의 소유자인지 확인
+func isPlanOwner(plan *models.TravelPlans, userID *uint) bool {
+	return plan.UserID != nil && userID != nil && *plan.UserID == *userID
+}
+
+// findOwnedPlan 경로의 :id 여행 계획을 조회하고 소유권을 확인
+// 실패 시 *fiber.Error를 반환하며, 앱 ErrorHandler가 응답으로 변환합니다
+func findOwnedPlan(c *fiber.Ctx) (*models.TravelPlans, error) {
+	var plan models.TravelPlans
+	if err := database.DB.Where("id = ?", c.Params("id")).First(&plan).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, "여행 계획을 찾을 수 없습니다")
+	}
+
+	if !isPlanOwner(&plan, currentUserID(c)) {
+		return nil, fiber.NewError(fiber.StatusForbidden, "이 여행 계획에 대한 권한이 없습니다")
+	}
+
+	return &plan, nil
 }
 
 // extractJSON 텍스트에서 JSON 부분만 추출
@@ -276,6 +698,31 @@ func adjustItineraryDays(itinerary []models.DayItinerary, targetDays int) []mode
 	return result
 }
 
+// pagination page/limit 쿼리 파싱 (limit는 1~50, 기본 10)
+func pagination(c *fiber.Ctx) (page, limit, offset int) {
+	page = c.QueryInt("page", 1)
+	limit = c.QueryInt("limit", 10)
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 50 {
+		limit = 10
+	}
+
+	return page, limit, (page - 1) * limit
+}
+
+// paginationMeta 목록 응답의 페이지 정보
+func paginationMeta(page, limit int, total int64) fiber.Map {
+	return fiber.Map{
+		"page":        page,
+		"limit":       limit,
+		"total":       total,
+		"total_pages": (total + int64(limit) - 1) / int64(limit),
+	}
+}
+
 // Helper functions for pointer types
 func getStringValue(ptr *string) string {
 	if ptr != nil {
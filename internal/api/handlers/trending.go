@@ -0,0 +1,67 @@
+// internal/api/handlers/trending.go
+package handlers
+
+import (
+	"tripwand-backend/internal/database"
+	"tripwand-backend/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetTrending 트렌딩 목적지와 인기 계획 조회
+// @Summary 트렌딩 목적지/계획
+// @Description 일별 조회 기록에 시간 감쇠와 신규 계획 가산점을 적용해(sort=trending과 같은 점수) 기간 내 인기 목적지와 공개 계획을 조회합니다. 여러 도시 여행은 방문하는 도시마다 목적지 순위에 반영됩니다
+// @Tags travel
+// @Produce json
+// @Param window query string false "집계 기간 - 오늘(UTC)을 포함한 최근 N일 (24h, 7d, 30d)" default(7d)
+// @Param limit query int false "항목 수" default(10)
+// @Success 200 {object} map[string]interface{} "트렌딩 목적지와 계획"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Router /api/v1/travel/trending [get]
+func (h *TravelHandler) GetTrending(c *fiber.Ctx) error {
+	window := c.Query("window", "7d")
+	limit := c.QueryInt("limit", 10)
+
+	days, ok := models.TrendingWindows[window]
+	if !ok {
+		return c.Status(400).JSON(fiber.Map{
+			"success": false,
+			"message": "집계 기간은 24h, 7d, 30d 중 하나여야 합니다",
+		})
+	}
+	if limit < 1 || limit > 50 {
+		limit = 10
+	}
+
+	destinations, err := models.GetTrendingDestinations(database.DB, days, limit)
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "트렌딩 조회 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	plans, err := models.GetTrendingPlans(database.DB, days, limit)
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"success": false,
+			"message": "트렌딩 조회 중 오류가 발생했습니다",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"destinations": destinations,
+			"plans":        plans,
+		},
+		"meta": fiber.Map{
+			"window":         window,
+			"limit":          limit,
+			"days":           days,
+			"half_life_days": models.TrendingHalfLifeDays,
+		},
+	})
+}
@@ -0,0 +1,31 @@
+// internal/api/middleware/admin.go
+package middleware
+
+import (
+	"tripwand-backend/internal/database"
+	"tripwand-backend/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AdminMiddleware - 관리자 권한 확인 (AuthMiddleware 뒤에 사용)
+func AdminMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, ok := c.Locals("user_id").(uint)
+		if !ok || userID == 0 {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "No token provided",
+			})
+		}
+
+		var user models.User
+		if err := database.DB.Select("id", "is_admin", "is_active").Where("id = ?", userID).First(&user).Error; err != nil ||
+			!user.IsAdmin || !user.IsActive {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "Admin permission required",
+			})
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// GuestCookieName 비회원 식별 쿠키 이름
+	GuestCookieName = "tripwand_guest"
+	// GuestTokenHeader 쿠키를 사용할 수 없는 클라이언트를 위한 헤더
+	GuestTokenHeader = "X-Guest-Token"
+
+	guestTokenTTL = 30 * 24 * time.Hour
+	guestSubject  = "guest"
+)
+
+type GuestClaims struct {
+	GuestID string `json:"guest_id"`
+	jwt.RegisteredClaims
+}
+
+// GuestMiddleware - 비회원 식별자 발급/확인 - Fiber 버전
+// 로그인 사용자는 건너뛰며, 유효한 게스트 토큰이 없으면 새로 발급해 쿠키와 헤더로 내려줍니다
+func GuestMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if _, ok := c.Locals("user_id").(uint); ok {
+			return c.Next()
+		}
+
+		if guestID := ExtractGuestID(c); guestID != "" {
+			c.Locals("guest_id", guestID)
+			return c.Next()
+		}
+
+		guestID, token, err := generateGuestToken()
+		if err != nil {
+			// 게스트 식별 실패는 요청 자체를 막지 않음
+			return c.Next()
+		}
+
+		setGuestCookie(c, token, time.Now().Add(guestTokenTTL))
+		c.Set(GuestTokenHeader, token)
+		c.Locals("guest_id", guestID)
+		return c.Next()
+	}
+}
+
+// ExtractGuestID - 요청의 게스트 토큰(쿠키 또는 헤더)을 검증하고 게스트 ID 반환 (없으면 "")
+func ExtractGuestID(c *fiber.Ctx) string {
+	token := c.Get(GuestTokenHeader)
+	if token == "" {
+		token = c.Cookies(GuestCookieName)
+	}
+	if token == "" {
+		return ""
+	}
+
+	claims, err := validateGuestToken(token)
+	if err != nil {
+		return ""
+	}
+	return claims.GuestID
+}
+
+// ClearGuestCookie - 게스트 쿠키 제거 (계획 이전 완료 후 호출)
+func ClearGuestCookie(c *fiber.Ctx) {
+	setGuestCookie(c, "", time.Unix(0, 0))
+}
+
+func setGuestCookie(c *fiber.Ctx, value string, expires time.Time) {
+	c.Cookie(&fiber.Cookie{
+		Name:     GuestCookieName,
+		Value:    value,
+		Path:     "/",
+		Expires:  expires,
+		HTTPOnly: true,
+		Secure:   os.Getenv("ENV") == "production",
+		SameSite: fiber.CookieSameSiteLaxMode,
+	})
+}
+
+func generateGuestToken() (string, string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	guestID := hex.EncodeToString(buf)
+
+	claims := &GuestClaims{
+		GuestID: guestID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   guestSubject,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(guestTokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(jwtSecret)
+	if err != nil {
+		return "", "", err
+	}
+
+	return guestID, token, nil
+}
+
+func validateGuestToken(tokenString string) (*GuestClaims, error) {
+	claims := &GuestClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return jwtSecret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, errors.New("invalid guest token")
+	}
+
+	// 사용자 access token이 게스트 토큰으로 쓰이지 않도록 subject 확인
+	if claims.Subject != guestSubject || claims.GuestID == "" {
+		return nil, errors.New("invalid guest token")
+	}
+
+	return claims, nil
+}
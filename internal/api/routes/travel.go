@@ -3,7 +3,10 @@ package routes
 
 import (
 	"tripwand-backend/internal/api/handlers"
+	"tripwand-backend/internal/api/middleware"
+	"tripwand-backend/internal/database"
 	"tripwand-backend/internal/llm"
+	"tripwand-backend/internal/stats"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -13,11 +16,11 @@ func SetupTravelRoutes(api fiber.Router, gemmaClient *llm.GemmaClient) {
 	// 여행 핸들러 초기화
 	travelHandler := handlers.NewTravelHandler(gemmaClient)
 
-	// 여행 라우트 그룹
-	travel := api.Group("/travel")
+	// 여행 라우트 그룹 (비회원도 사용 가능, 로그인 시 사용자 정보 주입)
+	travel := api.Group("/travel", middleware.OptionalAuthMiddleware())
 
-	// 여행 일정 생성
-	travel.Post("/generate", travelHandler.GenerateItinerary)
+	// 여행 일정 생성 (비회원은 게스트 식별자 발급)
+	travel.Post("/generate", middleware.GuestMiddleware(), travelHandler.GenerateItinerary)
 
 	// 저장된 여행 계획 목록 조회
 	travel.Get("/plans", travelHandler.GetSavedPlans)
@@ -25,19 +28,129 @@ func SetupTravelRoutes(api fiber.Router, gemmaClient *llm.GemmaClient) {
 	// 특정 여행 계획 상세 조회
 	travel.Get("/plans/:id", travelHandler.GetPlanByID)
 
-	// 여행 관련 통계 (선택사항)
+	// 여행 계획 수정 (소유자 전용)
+	travel.Patch("/plans/:id", middleware.AuthMiddleware(), travelHandler.UpdatePlan)
+
+	// 여행 계획 공개 여부 변경 (소유자 전용)
+	travel.Patch("/plans/:id/visibility", middleware.AuthMiddleware(), travelHandler.UpdatePlanVisibility)
+
+	// 여행 계획 삭제 - 휴지통으로 이동 (소유자 전용)
+	travel.Delete("/plans/:id", middleware.AuthMiddleware(), travelHandler.DeletePlan)
+
+	// 휴지통의 여행 계획 복원 (소유자 전용)
+	travel.Post("/plans/:id/restore", middleware.AuthMiddleware(), travelHandler.RestorePlan)
+
+	// 공유 링크 조회/발급(재발급)/해제 (소유자 전용)
+	travel.Get("/plans/:id/share", middleware.AuthMiddleware(), travelHandler.GetPlanShare)
+	travel.Post("/plans/:id/share", middleware.AuthMiddleware(), travelHandler.CreatePlanShare)
+	travel.Delete("/plans/:id/share", middleware.AuthMiddleware(), travelHandler.RevokePlanShare)
+
+	// 여행 일정 내보내기
+	travel.Get("/plans/:id/export", travelHandler.ExportPlan)
+	travel.Get("/plans/:id/export.ics", travelHandler.ExportPlanICS)
+	travel.Get("/plans/:id/export.geojson", travelHandler.ExportPlanGeoJSON)
+	travel.Get("/plans/:id/export.kml", travelHandler.ExportPlanKML)
+	travel.Get("/plans/:id/feasibility", travelHandler.CheckPlanFeasibility)
+	travel.Get("/plans/:id/constraints", travelHandler.CheckPlanConstraints)
+
+	// 공개 여행 계획을 내 계정으로 복사
+	travel.Post("/plans/:id/fork", middleware.AuthMiddleware(), travelHandler.ForkPlan)
+
+	// 좋아요 / 북마크 (여러 번 호출해도 결과 동일)
+	travel.Put("/plans/:id/like", middleware.AuthMiddleware(), travelHandler.LikePlan)
+	travel.Delete("/plans/:id/like", middleware.AuthMiddleware(), travelHandler.UnlikePlan)
+	travel.Put("/plans/:id/bookmark", middleware.AuthMiddleware(), travelHandler.BookmarkPlan)
+	travel.Delete("/plans/:id/bookmark", middleware.AuthMiddleware(), travelHandler.RemoveBookmark)
+
+	// 댓글 / 리뷰 (조회는 누구나, 작성·수정·삭제는 로그인 사용자)
+	travel.Get("/plans/:id/comments", travelHandler.GetPlanComments)
+	travel.Post("/plans/:id/comments", middleware.AuthMiddleware(), travelHandler.CreatePlanComment)
+	travel.Patch("/comments/:cid", middleware.AuthMiddleware(), travelHandler.UpdatePlanComment)
+	travel.Delete("/comments/:cid", middleware.AuthMiddleware(), travelHandler.DeletePlanComment)
+	travel.Get("/plans/:id/reviews", travelHandler.GetPlanReviews)
+	travel.Post("/plans/:id/reviews", middleware.AuthMiddleware(), travelHandler.CreatePlanReview)
+	travel.Patch("/reviews/:rid", middleware.AuthMiddleware(), travelHandler.UpdatePlanReview)
+	travel.Delete("/reviews/:rid", middleware.AuthMiddleware(), travelHandler.DeletePlanReview)
+
+	// 여행 계획/댓글 신고
+	travel.Post("/reports", middleware.AuthMiddleware(), travelHandler.ReportContent)
+
+	// 하루 또는 특정 시간대 일정 재생성 (소유자 전용)
+	travel.Post("/plans/:id/days/:day/regenerate", middleware.AuthMiddleware(), travelHandler.RegeneratePlanDay)
+
+	// 여행 계획 리비전 (소유자 전용) - diff는 :rev보다 먼저 등록
+	revisions := travel.Group("/plans/:id/revisions", middleware.AuthMiddleware())
+	revisions.Get("/", travelHandler.GetPlanRevisions)
+	revisions.Get("/diff", travelHandler.DiffPlanRevisions)
+	revisions.Get("/:rev", travelHandler.GetPlanRevision)
+	revisions.Post("/:rev/revert", travelHandler.RevertPlanRevision)
+
+	// 트렌딩 목적지와 인기 계획
+	travel.Get("/trending", travelHandler.GetTrending)
+
+	// 여행 관련 통계 (주기적으로 갱신되는 집계)
 	travel.Get("/stats", getTravelStats)
+
+	// 장소 이름 좌표 일괄 검색 (지명 사전)
+	api.Post("/geo/resolve", travelHandler.ResolvePlaces)
+
+	// 비용 환산에 사용하는 환율표
+	api.Get("/currency/rates", travelHandler.GetExchangeRates)
+
+	// 공유 링크로 여행 계획 조회 (로그인 불필요)
+	api.Get("/share/:token", travelHandler.GetSharedPlan)
+
+	// 내 정보 라우트 그룹 (로그인 필수)
+	me := api.Group("/me", middleware.AuthMiddleware())
+
+	// 내 여행 계획 목록 조회 (비공개 포함)
+	me.Get("/plans", travelHandler.GetMyPlans)
+
+	// 휴지통 목록 조회
+	me.Get("/plans/trash", travelHandler.GetTrashedPlans)
+
+	// 내 좋아요 / 북마크 / 컬렉션
+	me.Get("/likes", travelHandler.GetMyLikes)
+	me.Get("/bookmarks", travelHandler.GetMyBookmarks)
+	me.Get("/collections", travelHandler.GetMyCollections)
+	me.Post("/collections", travelHandler.CreateCollection)
+	me.Patch("/collections/:cid", travelHandler.UpdateCollection)
+	me.Delete("/collections/:cid", travelHandler.DeleteCollection)
+
+	// 비회원으로 생성한 여행 계획을 내 계정으로 이전
+	me.Post("/claim-guest-plans", travelHandler.ClaimGuestPlans)
+
+	// 관리자 라우트 그룹 (관리자 권한 필수)
+	admin := api.Group("/admin", middleware.AuthMiddleware(), middleware.AdminMiddleware())
+
+	// 콘텐츠 검토 큐
+	admin.Get("/moderation", travelHandler.GetModerationQueue)
+	admin.Post("/moderation/:id/approve", travelHandler.ApproveModerationItem)
+	admin.Post("/moderation/:id/hide", travelHandler.HideModerationItem)
+	admin.Post("/moderation/:id/delete", travelHandler.DeleteModerationItem)
+
+	// 환율표 업로드
+	admin.Post("/currency/rates", travelHandler.UploadExchangeRates)
 }
 
 // getTravelStats 여행 통계 조회
+// 주기적으로 갱신되는 스냅샷을 반환하며, 아직 계산 전이면 즉시 한 번 집계합니다
 func getTravelStats(c *fiber.Ctx) error {
-	// 간단한 통계 정보 반환
+	snapshot := stats.Current()
+	if snapshot == nil {
+		var err error
+		snapshot, err = stats.Refresh(database.DB)
+		if err != nil {
+			return c.Status(500).JSON(fiber.Map{
+				"success": false,
+				"message": "여행 통계 조회 중 오류가 발생했습니다",
+				"error":   err.Error(),
+			})
+		}
+	}
+
 	return c.JSON(fiber.Map{
 		"success": true,
-		"data": fiber.Map{
-			"total_plans_generated": 1234,
-			"popular_destinations":  []string{"부산", "제주도", "서울", "강릉", "경주"},
-			"average_duration":      3.2,
-		},
+		"data":    snapshot,
 	})
 }
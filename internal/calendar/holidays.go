@@ -0,0 +1,137 @@
+// internal/calendar/holidays.go
+package calendar
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+)
+
+// DateLayout 여행 날짜 형식
+const DateLayout = "2006-01-02"
+
+// holidaysCSV 한국 공휴일 달력 (date,name) - 대체공휴일과 선거일 포함, 매년 갱신 필요
+//
+//go:embed data/holidays_kr.csv
+var holidaysCSV []byte
+
+// weekdayLabels 요일의 한국어 표기
+var weekdayLabels = map[time.Weekday]string{
+	time.Sunday:    "일요일",
+	time.Monday:    "월요일",
+	time.Tuesday:   "화요일",
+	time.Wednesday: "수요일",
+	time.Thursday:  "목요일",
+	time.Friday:    "금요일",
+	time.Saturday:  "토요일",
+}
+
+// Day 여행 일차의 날짜 정보
+type Day struct {
+	Date    time.Time
+	Weekend bool
+	Holiday string // 공휴일 이름 (공휴일이 아니면 "")
+}
+
+// WeekdayLabel 요일의 한국어 표기 (예: "토요일")
+func (d Day) WeekdayLabel() string {
+	return WeekdayLabel(d.Date.Weekday())
+}
+
+// WeekdayLabel 요일의 한국어 표기
+func WeekdayLabel(weekday time.Weekday) string {
+	return weekdayLabels[weekday]
+}
+
+// DayOff 주말 또는 공휴일 여부
+func (d Day) DayOff() bool {
+	return d.Weekend || d.Holiday != ""
+}
+
+// Calendar 날짜별 공휴일 목록
+type Calendar struct {
+	holidays map[string]string // YYYY-MM-DD -> 이름
+	first    int               // 달력에 포함된 첫 해
+	last     int               // 달력에 포함된 마지막 해
+}
+
+var korea = mustParse(holidaysCSV)
+
+// Korea 내장된 한국 공휴일 달력
+func Korea() *Calendar {
+	return korea
+}
+
+// Parse date,name 형식의 CSV로 공휴일 달력 생성 (첫 줄은 헤더)
+func Parse(r io.Reader) (*Calendar, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+
+	if _, err := reader.Read(); err != nil {
+		return nil, fmt.Errorf("failed to read holiday header: %w", err)
+	}
+
+	cal := &Calendar{holidays: make(map[string]string)}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read holiday row: %w", err)
+		}
+
+		date, err := time.Parse(DateLayout, strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid holiday date %q: %w", record[0], err)
+		}
+		cal.holidays[date.Format(DateLayout)] = strings.TrimSpace(record[1])
+
+		if cal.first == 0 || date.Year() < cal.first {
+			cal.first = date.Year()
+		}
+		if date.Year() > cal.last {
+			cal.last = date.Year()
+		}
+	}
+
+	return cal, nil
+}
+
+// Covers 달력에 해당 연도의 공휴일이 포함되어 있는지 여부
+func (cal *Calendar) Covers(date time.Time) bool {
+	return date.Year() >= cal.first && date.Year() <= cal.last
+}
+
+// Holiday 공휴일 이름 조회 (공휴일이 아니면 "")
+func (cal *Calendar) Holiday(date time.Time) string {
+	return cal.holidays[date.Format(DateLayout)]
+}
+
+// Days 시작일부터 n일 동안의 날짜 정보
+func (cal *Calendar) Days(start time.Time, n int) []Day {
+	days := make([]Day, 0, n)
+	for i := 0; i < n; i++ {
+		date := start.AddDate(0, 0, i)
+		weekday := date.Weekday()
+		days = append(days, Day{
+			Date:    date,
+			Weekend: weekday == time.Saturday || weekday == time.Sunday,
+			Holiday: cal.Holiday(date),
+		})
+	}
+	return days
+}
+
+func mustParse(data []byte) *Calendar {
+	cal, err := Parse(bytes.NewReader(data))
+	if err != nil {
+		log.Fatalf("invalid bundled holiday calendar: %v", err)
+	}
+	return cal
+}
@@ -0,0 +1,88 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func date(t *testing.T, text string) time.Time {
+	t.Helper()
+	d, err := time.Parse(DateLayout, text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestKoreaHolidays(t *testing.T) {
+	tests := []struct {
+		date string
+		want string
+	}{
+		{date: "2025-03-03", want: "대체공휴일"}, // 삼일절이 토요일
+		{date: "2025-05-06", want: "대체공휴일"}, // 어린이날과 부처님오신날이 겹침
+		{date: "2025-10-08", want: "대체공휴일"}, // 추석 연휴 첫날이 일요일
+		{date: "2026-02-17", want: "설날"},
+		{date: "2026-05-25", want: "대체공휴일"}, // 부처님오신날이 일요일
+		{date: "2026-08-17", want: "대체공휴일"}, // 광복절이 토요일
+		{date: "2026-09-25", want: "추석"},
+		{date: "2026-09-28", want: ""},      // 추석 연휴가 목~토요일이라 대체공휴일 없음 (설날/추석은 토요일과 겹쳐도 대체하지 않음)
+		{date: "2026-10-05", want: "대체공휴일"}, // 개천절이 토요일
+		{date: "2026-06-08", want: ""},      // 현충일은 대체공휴일 대상이 아님
+		{date: "2027-02-08", want: "대체공휴일"}, // 설날 연휴 마지막 날이 일요일
+		{date: "2027-10-11", want: "대체공휴일"}, // 한글날이 토요일
+		{date: "2027-12-27", want: "대체공휴일"}, // 성탄절이 토요일 (2023년부터 대체공휴일 대상)
+	}
+
+	cal := Korea()
+	for _, tt := range tests {
+		if got := cal.Holiday(date(t, tt.date)); got != tt.want {
+			t.Errorf("Holiday(%s) = %q, want %q", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestKoreaSubstituteHolidaysOnWeekdays(t *testing.T) {
+	for text, name := range Korea().holidays {
+		if name != "대체공휴일" {
+			continue
+		}
+		if weekday := date(t, text).Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+			t.Errorf("substitute holiday %s falls on %s", text, weekday)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	cal, err := Parse(strings.NewReader("date,name\n2026-01-01,신정\n2027-03-01, 삼일절 \n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cal.Holiday(date(t, "2027-03-01")); got != "삼일절" {
+		t.Errorf("got %q, want trimmed name", got)
+	}
+	if !cal.Covers(date(t, "2026-12-31")) || cal.Covers(date(t, "2028-01-01")) {
+		t.Errorf("got coverage %d-%d, want 2026-2027", cal.first, cal.last)
+	}
+
+	if _, err := Parse(strings.NewReader("date,name\n2026/01/01,신정\n")); err == nil {
+		t.Error("expected error for invalid date")
+	}
+}
+
+func TestDays(t *testing.T) {
+	days := Korea().Days(date(t, "2026-09-24"), 4)
+	if len(days) != 4 {
+		t.Fatalf("got %d days, want 4", len(days))
+	}
+	if days[0].Holiday != "추석 연휴" || days[0].Weekend || !days[0].DayOff() {
+		t.Errorf("day 1: got %+v, want weekday holiday", days[0])
+	}
+	if !days[2].Weekend || days[2].WeekdayLabel() != "토요일" {
+		t.Errorf("day 3: got %+v, want 토요일", days[2])
+	}
+	if !days[3].Weekend || days[3].Holiday != "" {
+		t.Errorf("day 4: got %+v, want plain Sunday", days[3])
+	}
+}
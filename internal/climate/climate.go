@@ -0,0 +1,249 @@
+// internal/climate/climate.go
+package climate
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"tripwand-backend/internal/geo/region"
+)
+
+// Source 기후 정보 출처 안내
+const Source = "기상청 평년값(1991-2020) 기반 월별 근사치"
+
+// RainyPrecipitationMm 장마가 아니어도 비가 잦은 달로 보는 월 강수량 (mm)
+const RainyPrecipitationMm = 200
+
+// normalsCSV 지역별 월 평년값 (region,month,high_c,low_c,precip_mm,season)
+// season은 monsoon(장마), typhoon(태풍 영향 가능)을 |로 구분
+//
+//go:embed data/normals.csv
+var normalsCSV []byte
+
+// DefaultIndoorAlternatives AI가 실내 대안을 주지 않았을 때 사용하는 기본 대안
+var DefaultIndoorAlternatives = []string{
+	"박물관·미술관 관람",
+	"실내 전통시장이나 복합 쇼핑몰 둘러보기",
+	"지역 특색 카페와 맛집 탐방",
+	"찜질방·스파에서 휴식",
+}
+
+// Month 월별 기후 평년값
+type Month struct {
+	Region          string  `json:"region,omitempty" example:"부산"` // 여러 지역을 여행하는 경우 이 값이 속한 지역
+	Month           int     `json:"month" example:"7"`
+	AvgHighC        float64 `json:"avg_high_c" example:"29.1"`
+	AvgLowC         float64 `json:"avg_low_c" example:"22.4"`
+	PrecipitationMm float64 `json:"precipitation_mm" example:"414"`
+	Monsoon         bool    `json:"monsoon"`
+	Typhoon         bool    `json:"typhoon"`
+}
+
+// Rainy 장마철이거나 강수량이 많은 달인지 여부
+func (m Month) Rainy() bool {
+	return m.Monsoon || m.PrecipitationMm >= RainyPrecipitationMm
+}
+
+// Summary 여행 기간의 기후 요약 (TravelResponse의 climate 블록)
+type Summary struct {
+	Region             string   `json:"region" example:"서울"`
+	Months             []Month  `json:"months"`
+	RainySeason        bool     `json:"rainy_season"`
+	TyphoonSeason      bool     `json:"typhoon_season"`
+	IndoorAlternatives []string `json:"indoor_alternatives,omitempty" example:"국립중앙박물관,코엑스 별마당도서관"` // 비가 잦은 시기에만 포함
+	Source             string   `json:"source"`
+}
+
+var normals = mustParse(normalsCSV)
+
+// Parse 평년값 CSV 파싱 (첫 줄은 헤더) - 지역별 1~12월 값
+func Parse(r io.Reader) (map[string][12]Month, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 6
+
+	if _, err := reader.Read(); err != nil {
+		return nil, fmt.Errorf("failed to read climate header: %w", err)
+	}
+
+	result := make(map[string][12]Month)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read climate row: %w", err)
+		}
+
+		month, err := strconv.Atoi(record[1])
+		if err != nil || month < 1 || month > 12 {
+			return nil, fmt.Errorf("invalid month %q for %s", record[1], record[0])
+		}
+
+		var values [3]float64
+		for i, field := range record[2:5] {
+			if values[i], err = strconv.ParseFloat(field, 64); err != nil {
+				return nil, fmt.Errorf("invalid value %q for %s month %d", field, record[0], month)
+			}
+		}
+
+		months := result[record[0]]
+		months[month-1] = Month{
+			Month:           month,
+			AvgHighC:        values[0],
+			AvgLowC:         values[1],
+			PrecipitationMm: values[2],
+			Monsoon:         strings.Contains(record[5], "monsoon"),
+			Typhoon:         strings.Contains(record[5], "typhoon"),
+		}
+		result[record[0]] = months
+	}
+
+	return result, nil
+}
+
+// Lookup 목적지와 여행 날짜에 해당하는 월별 기후 (목적지를 알 수 없으면 nil)
+func Lookup(destination string, start time.Time, days int) *Summary {
+	for _, name := range region.Of(destination) {
+		months, ok := normals[name]
+		if !ok {
+			continue
+		}
+
+		summary := &Summary{Region: name, Source: Source}
+		seen := make(map[time.Month]bool)
+		for i := 0; i < days; i++ {
+			month := start.AddDate(0, 0, i).Month()
+			if seen[month] {
+				continue
+			}
+			seen[month] = true
+
+			normal := months[month-1]
+			summary.Months = append(summary.Months, normal)
+			summary.RainySeason = summary.RainySeason || normal.Rainy()
+			summary.TyphoonSeason = summary.TyphoonSeason || normal.Typhoon
+		}
+		return summary
+	}
+	return nil
+}
+
+// Stay 여러 도시 여행에서 한 도시에 머무는 기간
+type Stay struct {
+	Destination string
+	Start       time.Time
+	Days        int
+}
+
+// LookupStays 도시별로 머무는 기간의 기후를 방문 순서대로 합친 요약 (어느 도시도 알 수 없으면 nil)
+// 지역이 둘 이상이면 월별 값에 지역을 표시하고, 장마/태풍 여부는 한 도시라도 해당하면 true입니다
+func LookupStays(stays []Stay) *Summary {
+	merged := &Summary{Source: Source}
+	var regions []string
+	seen := make(map[string]bool)
+	for _, stay := range stays {
+		if stay.Days <= 0 {
+			continue
+		}
+		summary := Lookup(stay.Destination, stay.Start, stay.Days)
+		if summary == nil {
+			continue
+		}
+
+		if !seen[summary.Region] {
+			seen[summary.Region] = true
+			regions = append(regions, summary.Region)
+		}
+		for _, month := range summary.Months {
+			key := fmt.Sprintf("%s-%d", summary.Region, month.Month)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			month.Region = summary.Region
+			merged.Months = append(merged.Months, month)
+		}
+		merged.RainySeason = merged.RainySeason || summary.RainySeason
+		merged.TyphoonSeason = merged.TyphoonSeason || summary.TyphoonSeason
+	}
+
+	switch len(regions) {
+	case 0:
+		return nil
+	case 1:
+		for i := range merged.Months {
+			merged.Months[i].Region = ""
+		}
+	}
+	merged.Region = strings.Join(regions, ", ")
+	return merged
+}
+
+// Prompt 기후 정보를 Gemma 프롬프트 문구로 변환
+func (s *Summary) Prompt() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "여행 시기 %s의 평년 기후는 다음과 같습니다:\n", s.Region)
+	for _, m := range s.Months {
+		b.WriteString("- ")
+		if m.Region != "" {
+			b.WriteString(m.Region + " ")
+		}
+		fmt.Fprintf(&b, "%d월: 평균 최고 %.1f°C, 최저 %.1f°C, 월 강수량 약 %.0fmm", m.Month, m.AvgHighC, m.AvgLowC, m.PrecipitationMm)
+		switch {
+		case m.Monsoon:
+			b.WriteString(" (장마철)")
+		case m.Rainy():
+			b.WriteString(" (비가 잦음)")
+		}
+		if m.Typhoon {
+			b.WriteString(" (태풍 영향 가능)")
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n기온에 맞는 옷차림과 날씨 관련 주의사항을 구체적으로 포함해주세요.")
+	if s.RainySeason {
+		b.WriteString(` 비가 잦은 시기이므로 야외 활동이 있는 날에는 비가 올 때 대신 갈 수 있는 실내 장소를 함께 고려하고, 응답 JSON에 "climate": {"indoor_alternatives": ["실내 대안1", "실내 대안2"]} 항목을 추가해주세요.`)
+	}
+	if s.TyphoonSeason {
+		b.WriteString(" 태풍 영향으로 항공편이나 배편이 결항될 수 있으니 관련 주의사항도 포함해주세요.")
+	}
+
+	return b.String()
+}
+
+// WithAlternatives AI 응답의 실내 대안을 반영 (비가 잦은 시기에만, 없으면 기본 대안 사용)
+func (s *Summary) WithAlternatives(generated *Summary) *Summary {
+	if !s.RainySeason {
+		s.IndoorAlternatives = nil
+		return s
+	}
+
+	if generated != nil {
+		for _, alternative := range generated.IndoorAlternatives {
+			if trimmed := strings.TrimSpace(alternative); trimmed != "" {
+				s.IndoorAlternatives = append(s.IndoorAlternatives, trimmed)
+			}
+		}
+	}
+	if len(s.IndoorAlternatives) == 0 {
+		s.IndoorAlternatives = DefaultIndoorAlternatives
+	}
+	return s
+}
+
+func mustParse(data []byte) map[string][12]Month {
+	result, err := Parse(bytes.NewReader(data))
+	if err != nil {
+		log.Fatalf("invalid bundled climate normals: %v", err)
+	}
+	return result
+}
@@ -0,0 +1,101 @@
+package climate
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLookupStays(t *testing.T) {
+	start := time.Date(2026, 6, 29, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		stays       []Stay
+		wantNil     bool
+		wantRegion  string
+		wantMonths  []string // "지역-월" (지역 표시가 없으면 "-월")
+		wantRainy   bool
+		wantTyphoon bool
+	}{
+		{
+			name: "each city over its own dates",
+			stays: []Stay{
+				{Destination: "서울", Start: start, Days: 2},
+				{Destination: "부산", Start: start.AddDate(0, 0, 2), Days: 3},
+			},
+			wantRegion:  "서울, 부산",
+			wantMonths:  []string{"서울-6", "부산-7"},
+			wantRainy:   true,
+			wantTyphoon: true,
+		},
+		{
+			name: "single region has no month labels",
+			stays: []Stay{
+				{Destination: "서울", Start: start, Days: 2},
+				{Destination: "서울특별시", Start: start.AddDate(0, 0, 2), Days: 1},
+			},
+			wantRegion: "서울",
+			wantMonths: []string{"-6", "-7"},
+			wantRainy:  true,
+		},
+		{
+			name: "unknown city skipped",
+			stays: []Stay{
+				{Destination: "오사카", Start: start, Days: 2},
+				{Destination: "제주", Start: start.AddDate(0, 0, 2), Days: 2},
+			},
+			wantRegion:  "제주",
+			wantMonths:  []string{"-7"},
+			wantRainy:   true,
+			wantTyphoon: true,
+		},
+		{
+			name:    "no known city",
+			stays:   []Stay{{Destination: "오사카", Start: start, Days: 3}},
+			wantNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := LookupStays(tt.stays)
+			if tt.wantNil {
+				if summary != nil {
+					t.Fatalf("got %+v, want nil", summary)
+				}
+				return
+			}
+			if summary == nil {
+				t.Fatal("got nil summary")
+			}
+
+			var months []string
+			for _, m := range summary.Months {
+				months = append(months, fmt.Sprintf("%s-%d", m.Region, m.Month))
+			}
+			if summary.Region != tt.wantRegion || strings.Join(months, ",") != strings.Join(tt.wantMonths, ",") {
+				t.Errorf("got region %q months %v, want %q %v", summary.Region, months, tt.wantRegion, tt.wantMonths)
+			}
+			if summary.RainySeason != tt.wantRainy || summary.TyphoonSeason != tt.wantTyphoon {
+				t.Errorf("got rainy %v typhoon %v, want %v %v", summary.RainySeason, summary.TyphoonSeason, tt.wantRainy, tt.wantTyphoon)
+			}
+		})
+	}
+}
+
+func TestPromptLabelsRegions(t *testing.T) {
+	start := time.Date(2026, 6, 29, 0, 0, 0, 0, time.UTC)
+	summary := LookupStays([]Stay{
+		{Destination: "서울", Start: start, Days: 2},
+		{Destination: "부산", Start: start.AddDate(0, 0, 2), Days: 3},
+	})
+
+	prompt := summary.Prompt()
+	for _, want := range []string{"- 서울 6월:", "- 부산 7월:", "(장마철)"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("prompt missing %q:\n%s", want, prompt)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+// internal/constraints/constraints.go
+package constraints
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"tripwand-backend/internal/models"
+)
+
+// Rule 제약 조건별 키워드 규칙
+// 활동 요약/상세에 Avoid 키워드가 있으면 위반으로 보되, 같은 구절에서 allowWindow 글자 안에
+// Allow 키워드(예: "비건 메뉴가 있는 바비큐", "엘리베이터가 있는 동굴")가 있으면 그 키워드만 넘어갑니다
+// Except는 Allow 키워드를 포함하지만 다른 뜻인 단어입니다 (예: "펫"이 들어간 "카펫")
+type Rule struct {
+	Avoid  []string
+	Allow  []string
+	Except []string
+}
+
+// allowWindow Allow 키워드가 Avoid 키워드를 수식한다고 보는 최대 거리 (글자 수)
+const allowWindow = 12
+
+// clauseSeparators 구절을 나누는 문자 - Allow 키워드는 같은 구절의 Avoid 키워드에만 적용
+const clauseSeparators = ".,;!?()[]·/\n"
+
+// negations Allow 키워드 뒤에 오면 오히려 조건을 지원하지 않는다는 뜻이 되는 표현 (예: "반려동물 출입 불가")
+var negations = []string{"불가", "금지", "제한", "not allowed", "prohibited"}
+
+// meatKeywords 육류/생선 메뉴 (채식, 비건 공통)
+var meatKeywords = []string{
+	"삼겹살", "갈비", "불고기", "목살", "족발", "보쌈", "곱창", "막창", "대창", "순대", "돼지국밥", "수육",
+	"닭갈비", "치킨", "삼계탕", "닭한마리", "육회", "한우", "소고기", "돼지고기", "스테이크", "바비큐",
+	"회센터", "횟집", "생선회", "물회", "장어", "꼼장어", "대게", "킹크랩", "해물탕", "조개구이", "랍스터",
+	"steak", "bbq", "barbecue", "pork", "beef", "chicken", "sashimi", "seafood",
+}
+
+// Rules 지원하는 제약 조건 태그별 규칙
+var Rules = map[string]Rule{
+	models.ConstraintWheelchair: {
+		Avoid: []string{"등산", "산행", "하이킹", "트레킹", "암벽", "계단", "오름", "동굴", "출렁다리", "갯벌", "서핑", "카약", "패러글라이딩", "hiking", "trekking", "stairs", "climb"},
+		Allow: []string{"휠체어", "배리어프리", "무장애", "경사로", "엘리베이터", "wheelchair", "barrier-free"},
+	},
+	models.ConstraintStroller: {
+		Avoid: []string{"등산", "산행", "하이킹", "트레킹", "암벽", "계단", "오름", "동굴", "출렁다리", "갯벌", "hiking", "trekking", "stairs", "climb"},
+		Allow: []string{"유모차", "배리어프리", "무장애", "경사로", "엘리베이터", "stroller", "barrier-free"},
+	},
+	models.ConstraintLimitedWalking: {
+		Avoid: []string{"등산", "산행", "하이킹", "트레킹", "둘레길", "올레길", "도보 투어", "걷기 여행", "성곽길", "hiking", "trekking", "walking tour"},
+		Allow: []string{"셔틀", "전동카트", "모노레일", "케이블카", "shuttle", "cable car"},
+	},
+	models.ConstraintVegetarian: {
+		Avoid: meatKeywords,
+		Allow: []string{"채식", "비건", "사찰음식", "베지", "vegetarian", "vegan"},
+	},
+	models.ConstraintVegan: {
+		Avoid: append(append([]string{}, meatKeywords...), "치즈", "우유", "아이스크림", "버터", "계란", "달걀", "cheese", "ice cream", "egg"),
+		Allow: []string{"비건", "사찰음식", "식물성", "vegan", "plant-based"},
+	},
+	models.ConstraintHalal: {
+		Avoid: []string{"삼겹살", "목살", "돼지", "족발", "보쌈", "순대", "수육", "소주", "맥주", "막걸리", "와인", "칵테일", "양조장", "술집", "포차", "펍", "pork", "beer", "wine", "cocktail", "brewery"},
+		Allow: []string{"할랄", "halal", "무슬림", "muslim"},
+	},
+	models.ConstraintGlutenFree: {
+		Avoid: []string{"빵", "베이커리", "칼국수", "국수", "밀면", "짜장면", "짬뽕", "라면", "우동", "수제비", "파스타", "피자", "만두", "호떡", "맥주", "bakery", "bread", "noodle", "pasta", "pizza", "beer"},
+		Allow: []string{"글루텐 프리", "글루텐프리", "쌀국수", "gluten-free", "gluten free"},
+	},
+	models.ConstraintKids: {
+		Avoid:  []string{"술집", "칵테일 바", "와인 바", "루프탑 바", "클럽", "나이트클럽", "카지노", "펍", "포차", "양조장", "노키즈", "cocktail bar", "wine bar", "rooftop bar", "nightclub", "casino", "brewery", "no kids"},
+		Allow:  []string{"키즈", "어린이 동반 가능", "아이 동반 가능", "kids", "kid-friendly", "family-friendly"},
+		Except: []string{"노키즈", "no kids"},
+	},
+	models.ConstraintPets: {
+		Avoid:  []string{"박물관", "미술관", "수족관", "아쿠아리움", "놀이공원", "테마파크", "워터파크", "찜질방", "공연장", "museum", "aquarium", "theme park", "water park"},
+		Allow:  []string{"반려", "애견", "펫", "pet-friendly", "dog-friendly"},
+		Except: []string{"카펫", "트럼펫"},
+	},
+}
+
+// Violation 제약 조건에 맞지 않는 활동
+type Violation struct {
+	Day        int    `json:"day"`
+	Period     string `json:"period"`
+	Constraint string `json:"constraint"`
+	Keyword    string `json:"keyword"`
+	Summary    string `json:"summary"`
+	Message    string `json:"message"`
+}
+
+// Report 일정 전체의 제약 조건 검사 결과
+type Report struct {
+	Constraints []string    `json:"constraints"`
+	Checked     int         `json:"checked"` // 검사한 활동 수
+	Violations  []Violation `json:"violations"`
+}
+
+// Satisfied 위반 활동이 없는지 여부
+func (r *Report) Satisfied() bool {
+	return len(r.Violations) == 0
+}
+
+// Check 모든 활동의 요약과 상세를 제약 조건별 키워드 규칙으로 검사
+// 키워드 기반이므로 위반 가능성이 있는 활동을 알려주는 용도이며, 활동마다 조건별로 첫 키워드만 기록합니다
+func Check(data *models.TravelResponse, tags models.ConstraintTags) *Report {
+	report := &Report{Constraints: tags, Violations: []Violation{}}
+	if len(tags) == 0 {
+		return report
+	}
+
+	for _, day := range data.Itinerary {
+		for _, name := range models.PeriodNames {
+			period := day.Period(name)
+			text := strings.ToLower(period.Summary + "\n" + period.Detail)
+			report.Checked++
+
+			for _, tag := range tags {
+				rule, ok := Rules[tag]
+				if !ok {
+					continue
+				}
+				if keyword := rule.violation(text); keyword != "" {
+					report.Violations = append(report.Violations, Violation{
+						Day:        day.Day,
+						Period:     name,
+						Constraint: tag,
+						Keyword:    keyword,
+						Summary:    period.Summary,
+						Message: fmt.Sprintf("%d일차 %s '%s'에 %s 조건과 맞지 않을 수 있는 '%s'이(가) 포함되어 있습니다",
+							day.Day, models.PeriodLabels[name], period.Summary, models.ConstraintLabels[tag], keyword),
+					})
+				}
+			}
+		}
+	}
+
+	return report
+}
+
+// violation 소문자 text에서 Allow 키워드로 면제되지 않은 첫 Avoid 키워드 (없으면 "")
+func (r Rule) violation(text string) string {
+	for _, clause := range strings.FieldsFunc(text, func(c rune) bool {
+		return strings.ContainsRune(clauseSeparators, c)
+	}) {
+		allows := r.allowSpans(clause)
+		for _, keyword := range r.Avoid {
+			for _, avoid := range findAll(clause, keyword) {
+				if !nearAny(avoid, allows) {
+					return keyword
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// allowSpans 구절에서 조건을 지원한다는 뜻으로 쓰인 Allow 키워드 위치
+// Except 단어의 일부이거나 뒤에 부정 표현이 따라오면 제외합니다
+func (r Rule) allowSpans(clause string) []span {
+	var excepts, negated []span
+	for _, word := range r.Except {
+		excepts = append(excepts, findAll(clause, word)...)
+	}
+	for _, word := range negations {
+		negated = append(negated, findAll(clause, word)...)
+	}
+
+	var allows []span
+	for _, keyword := range r.Allow {
+		for _, allow := range findAll(clause, keyword) {
+			if !within(allow, excepts) && !followedBy(allow, negated) {
+				allows = append(allows, allow)
+			}
+		}
+	}
+	return allows
+}
+
+// span 구절 안에서 키워드의 글자 위치 [start, end)
+type span struct {
+	start, end int
+}
+
+// findAll text에서 keyword가 나오는 모든 위치 (대소문자 무시, text는 소문자)
+func findAll(text, keyword string) []span {
+	keyword = strings.ToLower(keyword)
+	var spans []span
+	for from := 0; ; {
+		idx := strings.Index(text[from:], keyword)
+		if idx < 0 {
+			return spans
+		}
+		start := from + idx
+		from = start + len(keyword)
+		spans = append(spans, span{
+			start: utf8.RuneCountInString(text[:start]),
+			end:   utf8.RuneCountInString(text[:from]),
+		})
+	}
+}
+
+// nearAny s와 allowWindow 글자 안에 있는 위치가 있는지 여부
+func nearAny(s span, others []span) bool {
+	for _, o := range others {
+		if max(o.start-s.end, s.start-o.end) <= allowWindow {
+			return true
+		}
+	}
+	return false
+}
+
+// within s가 others 중 하나의 안에 있는지 여부
+func within(s span, others []span) bool {
+	for _, o := range others {
+		if o.start <= s.start && s.end <= o.end {
+			return true
+		}
+	}
+	return false
+}
+
+// followedBy s 바로 뒤 allowWindow 글자 안에서 others가 시작하는지 여부
+func followedBy(s span, others []span) bool {
+	for _, o := range others {
+		if o.start >= s.end && o.start-s.end <= allowWindow {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package constraints
+
+import (
+	"testing"
+
+	"tripwand-backend/internal/models"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name        string
+		tag         string
+		summary     string
+		detail      string
+		wantKeyword string // "" 이면 위반 없음
+	}{
+		{name: "avoided term", tag: models.ConstraintVegetarian, summary: "해운대 돼지국밥", wantKeyword: "돼지국밥"},
+		{name: "allow qualifies nearby term", tag: models.ConstraintVegetarian, summary: "비건 메뉴가 있는 바비큐 식당", wantKeyword: ""},
+		{name: "allow in another clause does not exempt", tag: models.ConstraintVegetarian, summary: "점심은 삼겹살", detail: "디저트는 근처 비건 카페에서", wantKeyword: "삼겹살"},
+		{name: "allow too far away", tag: models.ConstraintVegetarian, summary: "채식 뷔페에서 아침을 먹고 오후 늦게 시장 구경 후 저녁은 삼겹살", wantKeyword: "삼겹살"},
+		{name: "every occurrence must be exempt", tag: models.ConstraintVegetarian, summary: "비건 스테이크 맛집", detail: "이어서 스테이크 하우스", wantKeyword: "스테이크"},
+		{name: "wheelchair access qualifies the place", tag: models.ConstraintWheelchair, summary: "엘리베이터가 있는 동굴 전시관", wantKeyword: ""},
+		{name: "family is not a kids exemption", tag: models.ConstraintKids, summary: "가족과 함께 루프탑 바", wantKeyword: "루프탑 바"},
+		{name: "no kids zone", tag: models.ConstraintKids, summary: "노키즈존 카페", wantKeyword: "노키즈"},
+		{name: "kids friendly pub", tag: models.ConstraintKids, summary: "키즈 메뉴가 있는 펍", wantKeyword: ""},
+		{name: "carpet is not pet friendly", tag: models.ConstraintPets, summary: "레드카펫 박물관 관람", wantKeyword: "박물관"},
+		{name: "pet friendly museum", tag: models.ConstraintPets, summary: "반려동물 동반 가능 박물관", wantKeyword: ""},
+		{name: "negated allow", tag: models.ConstraintPets, summary: "미술관 관람 (반려동물 출입 불가)", wantKeyword: "미술관"},
+		{name: "negated allow in same clause", tag: models.ConstraintPets, summary: "반려동물 출입 금지 미술관", wantKeyword: "미술관"},
+		{name: "case insensitive english", tag: models.ConstraintHalal, summary: "Craft BEER tasting", wantKeyword: "beer"},
+		{name: "no avoided terms", tag: models.ConstraintGlutenFree, summary: "한옥마을 산책", wantKeyword: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &models.TravelResponse{Itinerary: []models.DayItinerary{{
+				Day:     1,
+				Morning: models.ActivityPeriod{Summary: tt.summary, Detail: tt.detail},
+			}}}
+			report := Check(data, models.ConstraintTags{tt.tag})
+
+			if report.Checked != len(models.PeriodNames) {
+				t.Errorf("checked %d periods, want %d", report.Checked, len(models.PeriodNames))
+			}
+			if tt.wantKeyword == "" {
+				if !report.Satisfied() {
+					t.Errorf("got violations %+v, want none", report.Violations)
+				}
+				return
+			}
+			if len(report.Violations) != 1 {
+				t.Fatalf("got %d violations %+v, want 1", len(report.Violations), report.Violations)
+			}
+			v := report.Violations[0]
+			if v.Keyword != tt.wantKeyword || v.Constraint != tt.tag || v.Day != 1 || v.Period != "morning" {
+				t.Errorf("got %+v, want keyword %q", v, tt.wantKeyword)
+			}
+		})
+	}
+}
+
+func TestCheckWithoutTags(t *testing.T) {
+	data := &models.TravelResponse{Itinerary: []models.DayItinerary{{Day: 1, Morning: models.ActivityPeriod{Summary: "삼겹살"}}}}
+	report := Check(data, nil)
+	if report.Checked != 0 || !report.Satisfied() {
+		t.Errorf("got %+v, want empty report", report)
+	}
+}
+
+func TestCheckMultipleTags(t *testing.T) {
+	data := &models.TravelResponse{Itinerary: []models.DayItinerary{{
+		Day:     2,
+		Evening: models.ActivityPeriod{Summary: "양조장 투어와 삼겹살"},
+	}}}
+	report := Check(data, models.ConstraintTags{models.ConstraintHalal, models.ConstraintKids})
+
+	got := map[string]string{}
+	for _, v := range report.Violations {
+		got[v.Constraint] = v.Keyword
+	}
+	if got[models.ConstraintHalal] != "삼겹살" || got[models.ConstraintKids] != "양조장" {
+		t.Errorf("got %v, want halal 삼겹살 and kids 양조장", got)
+	}
+}
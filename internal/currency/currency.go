@@ -0,0 +1,253 @@
+// internal/currency/currency.go
+package currency
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"tripwand-backend/internal/models"
+)
+
+// Base 일정 비용의 기준 통화
+const Base = "KRW"
+
+// DateLayout 환율 기준일 형식
+const DateLayout = "2006-01-02"
+
+// builtinRatesCSV 내장 기본 환율표 (date,currency,krw_per_unit) - 업로드된 환율표가 없을 때 사용
+//
+//go:embed data/rates.csv
+var builtinRatesCSV []byte
+
+// Decimals 통화별 소수 자릿수 (ISO 4217)
+var Decimals = map[string]int{
+	"KRW": 0,
+	"USD": 2,
+	"EUR": 2,
+	"JPY": 0,
+	"CNY": 2,
+	"GBP": 2,
+	"TWD": 2,
+	"HKD": 2,
+	"SGD": 2,
+	"THB": 2,
+	"VND": 0,
+	"AUD": 2,
+	"CAD": 2,
+}
+
+// Table 기준일의 환율표 (외화 1단위당 원화)
+type Table struct {
+	Date   time.Time          `json:"date"`
+	Source string             `json:"source"`
+	Rates  map[string]float64 `json:"rates"`
+}
+
+var defaultTable atomic.Pointer[Table]
+
+func init() {
+	defaultTable.Store(Builtin())
+}
+
+// Default 현재 사용 중인 환율표
+func Default() *Table {
+	return defaultTable.Load()
+}
+
+// SetDefault 사용할 환율표 교체 (업로드 직후 등)
+func SetDefault(t *Table) {
+	defaultTable.Store(t)
+}
+
+// Builtin 내장 기본 환율표
+func Builtin() *Table {
+	t, err := Parse(bytes.NewReader(builtinRatesCSV))
+	if err != nil {
+		log.Fatalf("invalid builtin exchange rates: %v", err)
+	}
+	t.Source = "builtin"
+	return t
+}
+
+// Parse date,currency,krw_per_unit 형식의 CSV로 환율표 생성 (첫 줄은 헤더, 기준일은 모두 같아야 함)
+func Parse(r io.Reader) (*Table, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	if _, err := reader.Read(); err != nil {
+		return nil, fmt.Errorf("failed to read rates header: %w", err)
+	}
+
+	t := &Table{Rates: make(map[string]float64)}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read rates row: %w", err)
+		}
+
+		date, err := time.Parse(DateLayout, record[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid rates date %q", record[0])
+		}
+		if t.Date.IsZero() {
+			t.Date = date
+		} else if !t.Date.Equal(date) {
+			return nil, fmt.Errorf("rates must share one date, got %s and %s", t.Date.Format(DateLayout), record[0])
+		}
+
+		rate, err := strconv.ParseFloat(record[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate %q for %s", record[2], record[1])
+		}
+		t.Rates[strings.ToUpper(record[1])] = rate
+	}
+
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Validate 기준일과 환율 값 검증 (지원하는 통화, 0보다 큰 값)
+func (t *Table) Validate() error {
+	if t.Date.IsZero() {
+		return fmt.Errorf("rates date is required")
+	}
+	if len(t.Rates) == 0 {
+		return fmt.Errorf("at least one rate is required")
+	}
+	for code, rate := range t.Rates {
+		if _, ok := Decimals[code]; !ok || code == Base {
+			return fmt.Errorf("unsupported currency: %s", code)
+		}
+		if rate <= 0 || math.IsInf(rate, 0) || math.IsNaN(rate) {
+			return fmt.Errorf("rate for %s must be positive", code)
+		}
+	}
+	return nil
+}
+
+// Currencies 환율표로 변환할 수 있는 통화 (KRW 포함, 알파벳순)
+func (t *Table) Currencies() []string {
+	codes := []string{Base}
+	for code := range t.Rates {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Rate 외화 1단위당 원화 (KRW는 1)
+func (t *Table) Rate(code string) (float64, bool) {
+	if code == Base {
+		return 1, true
+	}
+	rate, ok := t.Rates[code]
+	return rate, ok
+}
+
+// Round 통화의 소수 자릿수에 맞춰 반올림 (0.5는 0에서 먼 쪽으로)
+func Round(value float64, code string) float64 {
+	scale := math.Pow(10, float64(Decimals[code]))
+	return math.Round(value*scale) / scale
+}
+
+// Conversion 일정 비용의 외화 환산 결과
+// 합계는 반올림한 항목 값의 합이므로 표시된 항목과 합계가 항상 일치합니다
+type Conversion struct {
+	Currency      string           `json:"currency" example:"USD"`
+	Rate          float64          `json:"rate" example:"1385.2"` // 외화 1단위당 원화
+	RatesDate     string           `json:"rates_date" example:"2026-10-01"`
+	RatesSource   string           `json:"rates_source"`
+	EstimatedCost float64          `json:"estimated_cost" example:"389.84"`
+	Budget        *ConvertedBudget `json:"budget,omitempty"`
+}
+
+// ConvertedItems 환산한 항목별 비용 (1인 기준)
+type ConvertedItems struct {
+	Lodging    float64 `json:"lodging"`
+	Food       float64 `json:"food"`
+	Transport  float64 `json:"transport"`
+	Activities float64 `json:"activities"`
+}
+
+// ConvertedDay 환산한 하루 비용
+type ConvertedDay struct {
+	Day int `json:"day"`
+	ConvertedItems
+	Total float64 `json:"total"`
+}
+
+// ConvertedBudget 환산한 예산 내역
+type ConvertedBudget struct {
+	Days           []ConvertedDay `json:"days"`
+	PerPerson      ConvertedItems `json:"per_person"`
+	PerPersonTotal float64        `json:"per_person_total"`
+	GroupSize      int            `json:"group_size"`
+	Total          float64        `json:"total"`
+}
+
+// Convert 일정의 예상 비용과 예산 항목을 지정한 통화로 환산
+func (t *Table) Convert(data *models.TravelResponse, code string) (*Conversion, error) {
+	code = strings.ToUpper(code)
+	rate, ok := t.Rate(code)
+	if !ok {
+		return nil, fmt.Errorf("unsupported currency: %s", code)
+	}
+
+	amount := func(krw int) float64 {
+		return Round(float64(krw)/rate, code)
+	}
+
+	conversion := &Conversion{
+		Currency:      code,
+		Rate:          rate,
+		RatesDate:     t.Date.Format(DateLayout),
+		RatesSource:   t.Source,
+		EstimatedCost: amount(data.EstimatedCost),
+	}
+
+	if b := data.Budget; b != nil {
+		budget := &ConvertedBudget{GroupSize: b.GroupSize}
+		for _, day := range b.Days {
+			items := ConvertedItems{
+				Lodging:    amount(day.Lodging),
+				Food:       amount(day.Food),
+				Transport:  amount(day.Transport),
+				Activities: amount(day.Activities),
+			}
+			budget.Days = append(budget.Days, ConvertedDay{Day: day.Day, ConvertedItems: items, Total: items.sum(code)})
+
+			budget.PerPerson.Lodging = Round(budget.PerPerson.Lodging+items.Lodging, code)
+			budget.PerPerson.Food = Round(budget.PerPerson.Food+items.Food, code)
+			budget.PerPerson.Transport = Round(budget.PerPerson.Transport+items.Transport, code)
+			budget.PerPerson.Activities = Round(budget.PerPerson.Activities+items.Activities, code)
+		}
+		budget.PerPersonTotal = budget.PerPerson.sum(code)
+		budget.Total = Round(budget.PerPersonTotal*float64(b.GroupSize), code)
+
+		conversion.Budget = budget
+		conversion.EstimatedCost = budget.PerPersonTotal
+	}
+
+	return conversion, nil
+}
+
+// sum 항목 합계 (부동소수점 오차 제거를 위해 다시 반올림)
+func (ci ConvertedItems) sum(code string) float64 {
+	return Round(ci.Lodging+ci.Food+ci.Transport+ci.Activities, code)
+}
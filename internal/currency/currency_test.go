@@ -0,0 +1,132 @@
+package currency
+
+import (
+	"testing"
+	"time"
+
+	"tripwand-backend/internal/models"
+)
+
+func testTable() *Table {
+	return &Table{
+		Date:   time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		Source: "test",
+		Rates:  map[string]float64{"USD": 1385.2, "JPY": 9.3, "EUR": 1500},
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		value float64
+		code  string
+		want  float64
+	}{
+		{value: 389.8354, code: "USD", want: 389.84},
+		{value: 0.125, code: "USD", want: 0.13}, // 0.5는 0에서 먼 쪽으로
+		{value: -0.125, code: "USD", want: -0.13},
+		{value: 2.5, code: "JPY", want: 3},
+		{value: 1234.4, code: "KRW", want: 1234},
+		{value: 1.005, code: "XXX", want: 1}, // 모르는 통화는 소수 없이
+	}
+	for _, tt := range tests {
+		if got := Round(tt.value, tt.code); got != tt.want {
+			t.Errorf("Round(%v, %s) = %v, want %v", tt.value, tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		estimatedCost int
+		want          float64
+		wantRate      float64
+		wantErr       bool
+	}{
+		{name: "usd two decimals", code: "USD", estimatedCost: 540000, want: 389.84, wantRate: 1385.2},
+		{name: "lowercase code", code: "usd", estimatedCost: 540000, want: 389.84, wantRate: 1385.2},
+		{name: "jpy no decimals", code: "JPY", estimatedCost: 540000, want: 58065, wantRate: 9.3},
+		{name: "base currency", code: "KRW", estimatedCost: 540000, want: 540000, wantRate: 1},
+		{name: "unsupported", code: "BTC", estimatedCost: 540000, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conversion, err := testTable().Convert(&models.TravelResponse{EstimatedCost: tt.estimatedCost}, tt.code)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", conversion)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if conversion.EstimatedCost != tt.want || conversion.Rate != tt.wantRate || conversion.Budget != nil {
+				t.Errorf("got cost %v rate %v budget %v, want %v %v nil", conversion.EstimatedCost, conversion.Rate, conversion.Budget, tt.want, tt.wantRate)
+			}
+			if conversion.RatesDate != "2026-10-01" || conversion.RatesSource != "test" {
+				t.Errorf("got rates date %q source %q", conversion.RatesDate, conversion.RatesSource)
+			}
+		})
+	}
+}
+
+// 합계는 반올림한 항목의 합이므로 원화 합계를 환산한 값과 다를 수 있지만 표시된 항목과는 항상 일치
+func TestConvertBudgetTotalsMatchRoundedItems(t *testing.T) {
+	data := &models.TravelResponse{
+		EstimatedCost: 99999,
+		Budget: &models.BudgetBreakdown{
+			Days: []models.DayBudget{
+				{Day: 1, BudgetItems: models.BudgetItems{Lodging: 1000, Food: 1000, Transport: 1000, Activities: 1000}},
+				{Day: 2, BudgetItems: models.BudgetItems{Lodging: 1000, Food: 1000}},
+			},
+			GroupSize: 3,
+		},
+	}
+
+	conversion, err := testTable().Convert(data, "EUR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := conversion.Budget
+
+	// 1000원 / 1500 = 0.6666... → 0.67
+	if b.Days[0].Lodging != 0.67 || b.Days[0].Total != 2.68 || b.Days[1].Total != 1.34 {
+		t.Errorf("got days %+v, want items 0.67 and totals 2.68, 1.34", b.Days)
+	}
+	if b.PerPerson.Lodging != 1.34 || b.PerPerson.Transport != 0.67 {
+		t.Errorf("got per person %+v", b.PerPerson)
+	}
+	if b.PerPersonTotal != 4.02 || b.Total != 12.06 || b.GroupSize != 3 {
+		t.Errorf("got per person total %v total %v group %d, want 4.02 12.06 3", b.PerPersonTotal, b.Total, b.GroupSize)
+	}
+	// 예산 내역이 있으면 estimated_cost도 항목 합계를 따름
+	if conversion.EstimatedCost != b.PerPersonTotal {
+		t.Errorf("got estimated cost %v, want per person total %v", conversion.EstimatedCost, b.PerPersonTotal)
+	}
+}
+
+func TestNewer(t *testing.T) {
+	older := &Table{Date: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)}
+	newer := &Table{Date: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)}
+	sameDay := &Table{Date: newer.Date}
+
+	tests := []struct {
+		name string
+		a, b *Table
+		want *Table
+	}{
+		{name: "b newer", a: older, b: newer, want: newer},
+		{name: "a newer", a: newer, b: older, want: newer},
+		{name: "same date prefers b", a: newer, b: sameDay, want: sameDay},
+		{name: "b missing", a: older, b: nil, want: older},
+		{name: "a missing", a: nil, b: older, want: older},
+	}
+	for _, tt := range tests {
+		if got := Newer(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: got %p, want %p", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// internal/currency/store.go
+package currency
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"tripwand-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// LoadFromDB 가장 최근에 업로드된 환율표 (업로드된 적이 없으면 nil)
+func LoadFromDB(db *gorm.DB) (*Table, error) {
+	snapshot, err := models.LatestRateSnapshot(db)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Table{Date: snapshot.RatesDate, Source: snapshot.Source}
+	if err := json.Unmarshal([]byte(snapshot.Rates), &t.Rates); err != nil {
+		return nil, fmt.Errorf("invalid rates in snapshot %d: %w", snapshot.ID, err)
+	}
+	if err := t.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid rates in snapshot %d: %w", snapshot.ID, err)
+	}
+	return t, nil
+}
+
+// Save 환율표를 저장 (같은 기준일은 덮어씀)
+func Save(db *gorm.DB, t *Table, uploadedBy *uint) error {
+	rates, err := json.Marshal(t.Rates)
+	if err != nil {
+		return err
+	}
+	return models.SaveRateSnapshot(db, &models.ExchangeRateSnapshot{
+		RatesDate:  t.Date,
+		Rates:      string(rates),
+		Source:     t.Source,
+		UploadedBy: uploadedBy,
+	})
+}
+
+// Newer 두 환율표 중 기준일이 더 최근인 것 (같으면 b)
+func Newer(a, b *Table) *Table {
+	if a == nil {
+		return b
+	}
+	if b == nil || a.Date.After(b.Date) {
+		return a
+	}
+	return b
+}
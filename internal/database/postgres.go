@@ -108,6 +108,46 @@ func Migrate() error {
 		return fmt.Errorf("failed to migrate travel_plans table: %w", err)
 	}
 
+	// 여행 계획 리비전 테이블 마이그레이션
+	if err := DB.AutoMigrate(&models.PlanRevision{}); err != nil {
+		return fmt.Errorf("failed to migrate plan_revisions table: %w", err)
+	}
+
+	// 여행 계획 일별 조회수 테이블 마이그레이션
+	if err := DB.AutoMigrate(&models.PlanDailyView{}); err != nil {
+		return fmt.Errorf("failed to migrate plan_daily_views table: %w", err)
+	}
+
+	// 좋아요 / 컬렉션 / 북마크 테이블 마이그레이션
+	if err := DB.AutoMigrate(&models.PlanLike{}, &models.PlanCollection{}, &models.PlanBookmark{}); err != nil {
+		return fmt.Errorf("failed to migrate engagement tables: %w", err)
+	}
+
+	// 댓글 / 리뷰 테이블 마이그레이션
+	if err := DB.AutoMigrate(&models.PlanComment{}, &models.PlanReview{}); err != nil {
+		return fmt.Errorf("failed to migrate feedback tables: %w", err)
+	}
+
+	// 콘텐츠 검수 / 신고 테이블 마이그레이션
+	if err := DB.AutoMigrate(&models.ModerationItem{}, &models.ContentReport{}); err != nil {
+		return fmt.Errorf("failed to migrate moderation tables: %w", err)
+	}
+
+	// 지명 사전 테이블 마이그레이션
+	if err := DB.AutoMigrate(&models.GeoPlace{}); err != nil {
+		return fmt.Errorf("failed to migrate geo_places: %w", err)
+	}
+
+	// 여러 도시 여행 구간 테이블 마이그레이션
+	if err := DB.AutoMigrate(&models.PlanLeg{}); err != nil {
+		return fmt.Errorf("failed to migrate plan_legs: %w", err)
+	}
+
+	// 환율표 테이블 마이그레이션
+	if err := DB.AutoMigrate(&models.ExchangeRateSnapshot{}); err != nil {
+		return fmt.Errorf("failed to migrate exchange_rate_snapshots: %w", err)
+	}
+
 	return nil
 }
 
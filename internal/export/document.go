@@ -0,0 +1,197 @@
+// internal/export/document.go
+package export
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"os"
+	"path/filepath"
+	texttemplate "text/template"
+
+	"tripwand-backend/internal/models"
+)
+
+//go:embed templates/*.tmpl
+var embeddedTemplates embed.FS
+
+// Format 문서 내보내기 형식
+type Format struct {
+	Template    string
+	ContentType string
+	Extension   string
+}
+
+// Formats 지원하는 문서 내보내기 형식
+var Formats = map[string]Format{
+	"md":   {Template: "plan.md.tmpl", ContentType: "text/markdown; charset=utf-8", Extension: "md"},
+	"html": {Template: "plan.html.tmpl", ContentType: "text/html; charset=utf-8", Extension: "html"},
+}
+
+// labels 언어별 문서 문구
+var labels = map[string]map[string]string{
+	"ko": {
+		"destination":    "목적지",
+		"duration":       "기간",
+		"duration_value": "%d일",
+		"day":            "%d일차",
+		"estimated_cost": "예상 경비",
+		"cost_value":     "%s원",
+		"cautions":       "주의사항",
+		"footer":         "TripWand에서 만든 여행 일정입니다",
+		"morning":        "아침",
+		"afternoon":      "오후",
+		"evening":        "저녁",
+		"night":          "밤",
+	},
+	"en": {
+		"destination":    "Destination",
+		"duration":       "Duration",
+		"duration_value": "%d days",
+		"day":            "Day %d",
+		"estimated_cost": "Estimated cost",
+		"cost_value":     "KRW %s",
+		"cautions":       "Things to note",
+		"footer":         "Itinerary created with TripWand",
+		"morning":        "Morning",
+		"afternoon":      "Afternoon",
+		"evening":        "Evening",
+		"night":          "Night",
+	},
+}
+
+// templateDir 템플릿을 덮어쓸 디렉터리 (비어 있으면 내장 템플릿 사용)
+var templateDir = ""
+
+// UseTemplateDir 내장 템플릿 대신 dir의 같은 이름 템플릿 사용 (운영 중 문서 양식 수정용)
+func UseTemplateDir(dir string) error {
+	for _, format := range Formats {
+		if _, err := os.Stat(filepath.Join(dir, format.Template)); err != nil {
+			return fmt.Errorf("template %s not found in %s: %w", format.Template, dir, err)
+		}
+	}
+	templateDir = dir
+	return nil
+}
+
+// PlanDocument 템플릿에 전달하는 여행 일정 문서
+type PlanDocument struct {
+	Language      string
+	Title         string
+	Destination   string
+	DurationText  string
+	EstimatedCost string
+	Cautions      []string
+	Days          []DayDocument
+	Labels        map[string]string
+}
+
+// DayDocument 하루 일정 문서
+type DayDocument struct {
+	Title   string
+	Periods []PeriodDocument
+}
+
+// PeriodDocument 시간대 활동 문서
+type PeriodDocument struct {
+	Label   string
+	Time    string // "09:00-11:30" (시각이 있는 활동만)
+	Summary string
+	Detail  string
+}
+
+// NewPlanDocument 여행 계획을 language(ko, en) 문구로 문서화 (알 수 없는 언어는 ko)
+func NewPlanDocument(plan *models.TravelPlans, data *models.TravelResponse, language string) *PlanDocument {
+	text, ok := labels[language]
+	if !ok {
+		language = "ko"
+		text = labels[language]
+	}
+
+	title := plan.Title
+	if title == "" {
+		title = models.DefaultPlanTitle(plan.Destination, plan.Duration)
+	}
+
+	doc := &PlanDocument{
+		Language:      language,
+		Title:         title,
+		Destination:   plan.Destination,
+		DurationText:  fmt.Sprintf(text["duration_value"], len(data.Itinerary)),
+		EstimatedCost: fmt.Sprintf(text["cost_value"], models.FormatThousands(data.EstimatedCost)),
+		Cautions:      data.Cautions,
+		Labels:        text,
+	}
+
+	for _, day := range data.Itinerary {
+		dayDoc := DayDocument{Title: fmt.Sprintf(text["day"], day.Day)}
+		for _, name := range models.PeriodNames {
+			period := day.Period(name)
+			if period.Summary == "" {
+				continue
+			}
+			dayDoc.Periods = append(dayDoc.Periods, PeriodDocument{
+				Label:   text[name],
+				Time:    periodTimeText(period),
+				Summary: period.Summary,
+				Detail:  period.Detail,
+			})
+		}
+		doc.Days = append(doc.Days, dayDoc)
+	}
+
+	return doc
+}
+
+// Render 문서를 지정한 형식(md, html)으로 렌더링
+func Render(format string, doc *PlanDocument) ([]byte, error) {
+	f, ok := Formats[format]
+	if !ok {
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+
+	source, err := readTemplate(f.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	// HTML은 일정 내용이 그대로 삽입되지 않도록 자동 이스케이프되는 html/template 사용
+	var tmpl interface {
+		Execute(w io.Writer, data any) error
+	}
+	if format == "html" {
+		tmpl, err = htmltemplate.New(f.Template).Parse(string(source))
+	} else {
+		tmpl, err = texttemplate.New(f.Template).Parse(string(source))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %s: %w", f.Template, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, doc); err != nil {
+		return nil, fmt.Errorf("failed to render %s: %w", format, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// readTemplate 덮어쓰기 디렉터리 또는 내장 템플릿에서 템플릿 원본 읽기
+func readTemplate(name string) ([]byte, error) {
+	if templateDir != "" {
+		return os.ReadFile(filepath.Join(templateDir, name))
+	}
+	return embeddedTemplates.ReadFile("templates/" + name)
+}
+
+// periodTimeText 활동 시각 표기 (시각이 없으면 "")
+func periodTimeText(period *models.ActivityPeriod) string {
+	switch {
+	case period.StartTime != "" && period.EndTime != "":
+		return period.StartTime + "-" + period.EndTime
+	case period.StartTime != "":
+		return period.StartTime
+	}
+	return ""
+}
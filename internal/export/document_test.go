@@ -0,0 +1,80 @@
+package export
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"tripwand-backend/internal/models"
+)
+
+var update = flag.Bool("update", false, "testdata의 golden 파일을 현재 렌더링 결과로 갱신")
+
+// testPlan 문서 렌더링 테스트용 여행 계획 (HTML 이스케이프가 필요한 문자 포함)
+func testPlan() (*models.TravelPlans, *models.TravelResponse) {
+	plan := &models.TravelPlans{
+		ID:          42,
+		Title:       "부산 2일 <먹방> 여행",
+		Destination: "부산",
+		Duration:    2,
+	}
+	data := &models.TravelResponse{
+		Itinerary: []models.DayItinerary{
+			{
+				Day:       1,
+				Morning:   models.ActivityPeriod{Summary: "해운대 산책", Detail: "해변을 따라 걷기", StartTime: "09:00", EndTime: "11:30"},
+				Afternoon: models.ActivityPeriod{Summary: "자갈치 시장", Detail: "회 & 물회 맛보기"},
+				Evening:   models.ActivityPeriod{Summary: "광안리 야경", StartTime: "19:00"},
+			},
+			{
+				Day:     2,
+				Morning: models.ActivityPeriod{Summary: "감천문화마을", Detail: "<골목> 사진 찍기"},
+				Night:   models.ActivityPeriod{Summary: "서면 \"포차\" 거리"},
+			},
+		},
+		EstimatedCost: 1250000,
+		Cautions:      []string{"주말에는 해운대가 붐빕니다", "현금 & 카드 준비"},
+	}
+	return plan, data
+}
+
+func TestRenderGolden(t *testing.T) {
+	plan, data := testPlan()
+
+	for _, language := range []string{"ko", "en"} {
+		for _, format := range []string{"md", "html"} {
+			name := "plan." + language + "." + format
+			t.Run(name, func(t *testing.T) {
+				got, err := Render(format, NewPlanDocument(plan, data, language))
+				if err != nil {
+					t.Fatalf("Render(%s): %v", format, err)
+				}
+
+				golden := filepath.Join("testdata", name+".golden")
+				if *update {
+					if err := os.WriteFile(golden, got, 0o644); err != nil {
+						t.Fatalf("failed to update golden file: %v", err)
+					}
+				}
+
+				want, err := os.ReadFile(golden)
+				if err != nil {
+					t.Fatalf("failed to read golden file (run with -update to create): %v", err)
+				}
+				if !bytes.Equal(got, want) {
+					t.Errorf("%s mismatch (run with -update if the change is intended)\n--- got ---\n%s\n--- want ---\n%s", golden, got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestNewPlanDocumentUnknownLanguage(t *testing.T) {
+	plan, data := testPlan()
+	doc := NewPlanDocument(plan, data, "fr")
+	if doc.Language != "ko" || doc.Days[0].Title != "1일차" {
+		t.Errorf("got language %q day title %q, want ko fallback", doc.Language, doc.Days[0].Title)
+	}
+}
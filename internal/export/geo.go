@@ -0,0 +1,201 @@
+// internal/export/geo.go
+package export
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"tripwand-backend/internal/geo"
+	"tripwand-backend/internal/models"
+)
+
+// Stop 일정 순서대로 방문하는 장소
+type Stop struct {
+	Day     int
+	Period  string
+	Order   int // 해당 일차 안에서의 방문 순서 (1부터)
+	Summary string
+	Place   geo.Place
+}
+
+// PlaceExtractor 텍스트에 언급된 장소를 찾는 지명 사전 (*geo.Gazetteer)
+type PlaceExtractor interface {
+	Extract(text, destination string) []geo.Place
+}
+
+// PlanStops 각 활동의 요약/상세에서 장소를 추출해 일정 순서대로 나열
+// 같은 시간대에서 같은 장소는 한 번만 포함하고, 좌표를 찾지 못한 이름은 제외합니다
+func PlanStops(data *models.TravelResponse, destination string, gazetteer PlaceExtractor) []Stop {
+	var stops []Stop
+	for _, day := range data.Itinerary {
+		order := 0
+		for _, name := range models.PeriodNames {
+			period := day.Period(name)
+			for _, place := range gazetteer.Extract(period.Summary+"\n"+period.Detail, destination) {
+				order++
+				stops = append(stops, Stop{
+					Day:     day.Day,
+					Period:  name,
+					Order:   order,
+					Summary: period.Summary,
+					Place:   place,
+				})
+			}
+		}
+	}
+	return stops
+}
+
+// geoJSONFeature GeoJSON Feature (RFC 7946)
+type geoJSONFeature struct {
+	Type       string                 `json:"type"`
+	Geometry   geoJSONGeometry        `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// geoJSONGeometry GeoJSON Geometry - 좌표는 [경도, 위도] 순서
+type geoJSONGeometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+// GeoJSON 장소를 Point로, 일차별 이동 경로(장소가 2곳 이상인 날)를 LineString으로 담은 FeatureCollection
+func GeoJSON(plan *models.TravelPlans, stops []Stop) ([]byte, error) {
+	features := make([]geoJSONFeature, 0, len(stops))
+	for _, stop := range stops {
+		features = append(features, geoJSONFeature{
+			Type: "Feature",
+			Geometry: geoJSONGeometry{
+				Type:        "Point",
+				Coordinates: []float64{stop.Place.Lng, stop.Place.Lat},
+			},
+			Properties: map[string]interface{}{
+				"name":    stop.Place.Name,
+				"region":  stop.Place.Region,
+				"day":     stop.Day,
+				"period":  stop.Period,
+				"order":   stop.Order,
+				"summary": stop.Summary,
+			},
+		})
+	}
+
+	for _, route := range groupByDay(stops) {
+		if len(route) < 2 {
+			continue
+		}
+		coordinates := make([][]float64, 0, len(route))
+		for _, stop := range route {
+			coordinates = append(coordinates, []float64{stop.Place.Lng, stop.Place.Lat})
+		}
+		features = append(features, geoJSONFeature{
+			Type: "Feature",
+			Geometry: geoJSONGeometry{
+				Type:        "LineString",
+				Coordinates: coordinates,
+			},
+			Properties: map[string]interface{}{
+				"day":   route[0].Day,
+				"stops": len(route),
+			},
+		})
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"type":     "FeatureCollection",
+		"features": features,
+		"properties": map[string]interface{}{
+			"plan_id":     plan.ID,
+			"title":       plan.Title,
+			"destination": plan.Destination,
+		},
+	})
+}
+
+// kmlDocument KML 2.2 문서
+type kmlDocument struct {
+	XMLName     xml.Name    `xml:"kml"`
+	Xmlns       string      `xml:"xmlns,attr"`
+	Name        string      `xml:"Document>name"`
+	Description string      `xml:"Document>description,omitempty"`
+	Folders     []kmlFolder `xml:"Document>Folder"`
+}
+
+type kmlFolder struct {
+	Name       string         `xml:"name"`
+	Placemarks []kmlPlacemark `xml:"Placemark"`
+}
+
+type kmlPlacemark struct {
+	Name        string         `xml:"name"`
+	Description string         `xml:"description,omitempty"`
+	Point       *kmlPoint      `xml:"Point,omitempty"`
+	LineString  *kmlLineString `xml:"LineString,omitempty"`
+}
+
+type kmlPoint struct {
+	Coordinates string `xml:"coordinates"`
+}
+
+type kmlLineString struct {
+	Tessellate  int    `xml:"tessellate"`
+	Coordinates string `xml:"coordinates"`
+}
+
+// KML 일차별 폴더에 장소 Placemark와 이동 경로 LineString을 담은 KML 문서
+func KML(plan *models.TravelPlans, stops []Stop) ([]byte, error) {
+	doc := kmlDocument{
+		Xmlns:       "http://www.opengis.net/kml/2.2",
+		Name:        plan.Title,
+		Description: plan.Destination,
+	}
+
+	for _, route := range groupByDay(stops) {
+		day := route[0].Day
+		folder := kmlFolder{Name: fmt.Sprintf("%d일차", day)}
+		for _, stop := range route {
+			folder.Placemarks = append(folder.Placemarks, kmlPlacemark{
+				Name:        fmt.Sprintf("%d. %s", stop.Order, stop.Place.Name),
+				Description: fmt.Sprintf("%s · %s", models.PeriodLabels[stop.Period], stop.Summary),
+				Point:       &kmlPoint{Coordinates: kmlCoordinate(stop.Place)},
+			})
+		}
+		if len(route) >= 2 {
+			coordinates := make([]string, 0, len(route))
+			for _, stop := range route {
+				coordinates = append(coordinates, kmlCoordinate(stop.Place))
+			}
+			folder.Placemarks = append(folder.Placemarks, kmlPlacemark{
+				Name:       fmt.Sprintf("%d일차 이동 경로", day),
+				LineString: &kmlLineString{Tessellate: 1, Coordinates: strings.Join(coordinates, " ")},
+			})
+		}
+		doc.Folders = append(doc.Folders, folder)
+	}
+
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+// groupByDay 일차별 방문 장소 (일정 순서 유지)
+func groupByDay(stops []Stop) [][]Stop {
+	var groups [][]Stop
+	for _, stop := range stops {
+		if n := len(groups); n > 0 && groups[n-1][0].Day == stop.Day {
+			groups[n-1] = append(groups[n-1], stop)
+			continue
+		}
+		groups = append(groups, []Stop{stop})
+	}
+	return groups
+}
+
+// kmlCoordinate KML 좌표 표기 (경도,위도)
+func kmlCoordinate(place geo.Place) string {
+	return fmt.Sprintf("%.6f,%.6f", place.Lng, place.Lat)
+}
@@ -0,0 +1,139 @@
+// internal/export/ics.go
+package export
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"tripwand-backend/internal/models"
+)
+
+// TimeSlot 시간대별 기본 일정 시각 (HH:MM)
+type TimeSlot struct {
+	Start string
+	End   string
+}
+
+// PeriodSlots 활동에 시각이 없을 때 사용하는 시간대별 기본 시각
+var PeriodSlots = map[string]TimeSlot{
+	"morning":   {Start: "09:00", End: "12:00"},
+	"afternoon": {Start: "13:00", End: "17:00"},
+	"evening":   {Start: "18:00", End: "20:00"},
+	"night":     {Start: "20:00", End: "22:00"},
+}
+
+// icsLineLimit iCalendar 한 줄 최대 길이 (옥텟, 줄바꿈 제외)
+const icsLineLimit = 75
+
+// icsTimeLayout UTC 일시 형식
+const icsTimeLayout = "20060102T150405Z"
+
+// ICS 여행 일정을 iCalendar(RFC 5545) 문서로 변환
+// 각 일차의 시간대 활동이 하나의 일정이 되며, start는 1일차 날짜(loc 기준)입니다
+// 시각은 활동의 start_time/end_time을 우선 사용하고 없으면 PeriodSlots를 사용합니다
+func ICS(plan *models.TravelPlans, data *models.TravelResponse, start time.Time, loc *time.Location, now time.Time) []byte {
+	var b strings.Builder
+
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:-//TripWand//Travel Itinerary//KO")
+	writeICSLine(&b, "CALSCALE:GREGORIAN")
+	writeICSLine(&b, "METHOD:PUBLISH")
+	writeICSLine(&b, "X-WR-CALNAME:"+escapeICSText(plan.Title))
+	writeICSLine(&b, "X-WR-TIMEZONE:"+loc.String())
+
+	stamp := now.UTC().Format(icsTimeLayout)
+	for i, day := range data.Itinerary {
+		date := time.Date(start.Year(), start.Month(), start.Day()+i, 0, 0, 0, 0, loc)
+
+		for _, name := range models.PeriodNames {
+			period := day.Period(name)
+			if period.Summary == "" {
+				continue
+			}
+
+			begin, end := periodTimes(date, name, period)
+
+			writeICSLine(&b, "BEGIN:VEVENT")
+			writeICSLine(&b, fmt.Sprintf("UID:plan-%d-day-%d-%s@tripwand", plan.ID, day.Day, name))
+			writeICSLine(&b, "DTSTAMP:"+stamp)
+			writeICSLine(&b, "DTSTART:"+begin.UTC().Format(icsTimeLayout))
+			writeICSLine(&b, "DTEND:"+end.UTC().Format(icsTimeLayout))
+			writeICSLine(&b, "SUMMARY:"+escapeICSText(fmt.Sprintf("%d일차 %s · %s", day.Day, models.PeriodLabels[name], period.Summary)))
+			if period.Detail != "" {
+				writeICSLine(&b, "DESCRIPTION:"+escapeICSText(period.Detail))
+			}
+			writeICSLine(&b, "LOCATION:"+escapeICSText(plan.Destination))
+			writeICSLine(&b, "END:VEVENT")
+		}
+	}
+
+	writeICSLine(&b, "END:VCALENDAR")
+	return []byte(b.String())
+}
+
+// periodTimes 활동의 시작/종료 시각 (종료가 시작보다 이르면 다음 날로 간주)
+func periodTimes(date time.Time, name string, period *models.ActivityPeriod) (time.Time, time.Time) {
+	slot := PeriodSlots[name]
+	begin := atClock(date, slot.Start)
+	if period.StartTime != "" {
+		begin = atClock(date, period.StartTime)
+	}
+
+	var end time.Time
+	switch {
+	case period.EndTime != "":
+		end = atClock(date, period.EndTime)
+	case period.StartTime != "":
+		// 시작 시각만 있으면 기본 시간대 길이만큼 진행
+		end = begin.Add(atClock(date, slot.End).Sub(atClock(date, slot.Start)))
+	default:
+		end = atClock(date, slot.End)
+	}
+
+	if !end.After(begin) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return begin, end
+}
+
+// atClock 날짜에 HH:MM 시각 적용 (형식이 잘못되면 자정)
+func atClock(date time.Time, clock string) time.Time {
+	t, err := time.Parse(models.ActivityTimeLayout, clock)
+	if err != nil {
+		return date
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), t.Hour(), t.Minute(), 0, 0, date.Location())
+}
+
+// escapeICSText TEXT 값의 특수문자 이스케이프
+func escapeICSText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", "",
+	).Replace(value)
+}
+
+// writeICSLine 75옥텟 단위로 접어서(folding) 한 줄 기록 - 멀티바이트 문자는 쪼개지 않음
+func writeICSLine(b *strings.Builder, line string) {
+	limit := icsLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// 이어지는 줄은 앞의 공백 1옥텟을 포함해 75옥텟
+		limit = icsLineLimit - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
@@ -0,0 +1,81 @@
+package export
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestEscapeICSText(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "plain", value: "해운대 산책", want: "해운대 산책"},
+		{name: "backslash", value: `C:\trip`, want: `C:\\trip`},
+		{name: "separators", value: "회, 물회; 대게", want: `회\, 물회\; 대게`},
+		{name: "newlines", value: "첫 줄\r\n둘째 줄\n셋째 줄", want: `첫 줄\n둘째 줄\n셋째 줄`},
+		{name: "bare carriage return dropped", value: "a\rb", want: "ab"},
+		{name: "escaped backslash before separator", value: `\,`, want: `\\\,`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := escapeICSText(tt.value); got != tt.want {
+				t.Errorf("escapeICSText(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteICSLine(t *testing.T) {
+	tests := []struct {
+		name      string
+		line      string
+		wantLines int
+	}{
+		{name: "short", line: "BEGIN:VCALENDAR", wantLines: 1},
+		{name: "exactly limit", line: strings.Repeat("a", icsLineLimit), wantLines: 1},
+		{name: "one over limit", line: strings.Repeat("a", icsLineLimit+1), wantLines: 2},
+		{name: "continuation limit includes leading space", line: strings.Repeat("a", icsLineLimit+icsLineLimit-1+1), wantLines: 3},
+		{name: "multibyte", line: "SUMMARY:" + strings.Repeat("해운대", 20), wantLines: 3},
+		{name: "mixed", line: "DESCRIPTION:" + strings.Repeat("광안리 beach 산책, ", 10), wantLines: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			writeICSLine(&b, tt.line)
+			out := b.String()
+
+			if !strings.HasSuffix(out, "\r\n") {
+				t.Fatalf("output %q does not end with CRLF", out)
+			}
+			lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+			if len(lines) != tt.wantLines {
+				t.Errorf("got %d lines, want %d: %q", len(lines), tt.wantLines, lines)
+			}
+
+			var unfolded strings.Builder
+			for i, line := range lines {
+				if len(line) > icsLineLimit {
+					t.Errorf("line %d is %d octets, want at most %d", i, len(line), icsLineLimit)
+				}
+				if !utf8.ValidString(line) {
+					t.Errorf("line %d splits a multibyte character: %q", i, line)
+				}
+				if i > 0 {
+					if !strings.HasPrefix(line, " ") {
+						t.Fatalf("continuation line %d does not start with a space: %q", i, line)
+					}
+					line = line[1:]
+				}
+				unfolded.WriteString(line)
+			}
+			if unfolded.String() != tt.line {
+				t.Errorf("unfolded %q, want %q", unfolded.String(), tt.line)
+			}
+		})
+	}
+}
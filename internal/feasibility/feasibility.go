@@ -0,0 +1,219 @@
+// internal/feasibility/feasibility.go
+package feasibility
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"tripwand-backend/internal/export"
+	"tripwand-backend/internal/geo"
+	"tripwand-backend/internal/models"
+)
+
+// DefaultMode 이동 수단을 지정하지 않았을 때 사용하는 수단
+const DefaultMode = "transit"
+
+// earthRadiusKm 지구 평균 반지름 (km)
+const earthRadiusKm = 6371.0
+
+// Profile 이동 수단별 속도 프로필
+// 짧은 구간은 시내 속도, LongDistanceFromKm를 넘는 구간은 장거리 속도(고속도로, KTX/시외버스)로 계산합니다
+type Profile struct {
+	UrbanKmh           float64
+	LongDistanceKmh    float64
+	LongDistanceFromKm float64
+	DetourFactor       float64 // 직선 거리 대비 실제 경로 길이 비율
+	OverheadMinutes    int     // 대기, 환승, 주차 등 고정 소요 시간
+}
+
+// Profiles 지원하는 이동 수단 (walking, transit, car)
+var Profiles = map[string]Profile{
+	"walking": {UrbanKmh: 4.5, DetourFactor: 1.3},
+	"transit": {UrbanKmh: 20, LongDistanceKmh: 110, LongDistanceFromKm: 40, DetourFactor: 1.4, OverheadMinutes: 15},
+	"car":     {UrbanKmh: 30, LongDistanceKmh: 80, LongDistanceFromKm: 20, DetourFactor: 1.3, OverheadMinutes: 10},
+}
+
+// TravelMinutes 직선 거리(km)를 이동 시간(분)으로 환산
+func (p Profile) TravelMinutes(distanceKm float64) int {
+	if distanceKm <= 0 {
+		return 0
+	}
+
+	route := distanceKm * p.DetourFactor
+	hours := route / p.UrbanKmh
+	if p.LongDistanceKmh > 0 && route > p.LongDistanceFromKm {
+		hours = p.LongDistanceFromKm/p.UrbanKmh + (route-p.LongDistanceFromKm)/p.LongDistanceKmh
+	}
+	return p.OverheadMinutes + int(math.Ceil(hours*60))
+}
+
+// Haversine 두 장소 사이의 대원 거리 (km)
+func Haversine(a, b geo.Place) float64 {
+	lat1, lat2 := a.Lat*math.Pi/180, b.Lat*math.Pi/180
+	dLat := lat2 - lat1
+	dLng := (b.Lng - a.Lng) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Warning 시간 안에 이동할 수 없는 활동 간 이동
+type Warning struct {
+	Day            int     `json:"day"`
+	FromPeriod     string  `json:"from_period"`
+	ToPeriod       string  `json:"to_period"`
+	From           string  `json:"from"`
+	To             string  `json:"to"`
+	DistanceKm     float64 `json:"distance_km"`
+	TravelMinutes  int     `json:"travel_minutes"`
+	AllowedMinutes int     `json:"allowed_minutes"`
+	Message        string  `json:"message"`
+}
+
+// Report 일정 전체의 이동 가능성 검사 결과
+type Report struct {
+	Mode         string    `json:"mode"`
+	Checked      int       `json:"checked"` // 검사한 이동 구간 수 (좌표를 찾은 장소 사이만)
+	Warnings     []Warning `json:"warnings"`
+	RepairedDays []int     `json:"repaired_days,omitempty"`
+}
+
+// Feasible 경고가 없는지 여부
+func (r *Report) Feasible() bool {
+	return len(r.Warnings) == 0
+}
+
+// FlaggedDays 경고가 있는 일차 (오름차순)
+func (r *Report) FlaggedDays() []int {
+	seen := make(map[int]bool)
+	var days []int
+	for _, warning := range r.Warnings {
+		if !seen[warning.Day] {
+			seen[warning.Day] = true
+			days = append(days, warning.Day)
+		}
+	}
+	sort.Ints(days)
+	return days
+}
+
+// RepairInstructions 해당 일차의 경고를 AI 재생성 요청사항으로 정리
+func (r *Report) RepairInstructions(day int) string {
+	var problems []string
+	for _, warning := range r.Warnings {
+		if warning.Day == day {
+			problems = append(problems, fmt.Sprintf("%s(%s)에서 %s(%s)까지 약 %.0fkm로 %s %d분이 걸림",
+				warning.From, models.PeriodLabels[warning.FromPeriod],
+				warning.To, models.PeriodLabels[warning.ToPeriod],
+				warning.DistanceKm, models.TransportModeLabels[r.Mode], warning.TravelMinutes))
+		}
+	}
+	if len(problems) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("현재 일정은 이동 시간이 부족합니다 (%s). %s 이동을 기준으로 하루 동선이 현실적으로 이어지도록 가까운 장소 위주로 다시 구성해주세요.",
+		strings.Join(problems, "; "), models.TransportModeLabels[r.Mode])
+}
+
+// Check 같은 날 연속된 활동 사이의 이동 시간이 허용 시간을 넘는지 검사
+// 숙소를 옮기는 경우가 있으므로 날짜가 바뀌는 이동(전날 밤 → 다음 날 아침)과 여러 도시 여행의 도시 간 이동일은 검사하지 않습니다
+func Check(data *models.TravelResponse, destination string, gazetteer *geo.Gazetteer, mode string) *Report {
+	profile, ok := Profiles[mode]
+	if !ok {
+		mode = DefaultMode
+		profile = Profiles[mode]
+	}
+
+	report := &Report{Mode: mode, Warnings: []Warning{}}
+	stops := export.PlanStops(data, destination, gazetteer)
+
+	for i := 1; i < len(stops); i++ {
+		prev, next := stops[i-1], stops[i]
+		if prev.Day != next.Day || prev.Place.Name == next.Place.Name {
+			continue
+		}
+
+		day := findDay(data, next.Day)
+		if day == nil || day.TransferFrom != "" {
+			continue
+		}
+
+		report.Checked++
+		distance := Haversine(prev.Place, next.Place)
+		minutes := profile.TravelMinutes(distance)
+		allowed := allowedMinutes(day, prev.Period, next.Period)
+		if minutes <= allowed {
+			continue
+		}
+
+		report.Warnings = append(report.Warnings, Warning{
+			Day:            next.Day,
+			FromPeriod:     prev.Period,
+			ToPeriod:       next.Period,
+			From:           prev.Place.Name,
+			To:             next.Place.Name,
+			DistanceKm:     math.Round(distance*10) / 10,
+			TravelMinutes:  minutes,
+			AllowedMinutes: allowed,
+			Message: fmt.Sprintf("%d일차 %s(%s)에서 %s(%s)까지 약 %.0fkm로 %s 약 %d분이 걸리지만 이동 가능한 시간은 %d분입니다",
+				next.Day, prev.Place.Name, models.PeriodLabels[prev.Period],
+				next.Place.Name, models.PeriodLabels[next.Period],
+				distance, models.TransportModeLabels[mode], minutes, allowed),
+		})
+	}
+
+	return report
+}
+
+// allowedMinutes 두 활동 사이에 이동에 쓸 수 있는 시간 (분)
+// - 같은 시간대: 시간대 길이의 절반 (나머지는 활동 시간)
+// - 다른 시간대: 두 시간대 사이 공백 + 다음 시간대 길이의 절반
+// - 앞 활동의 종료 시각과 다음 활동의 시작 시각이 모두 있으면 그 사이 간격만 허용
+func allowedMinutes(day *models.DayItinerary, fromPeriod, toPeriod string) int {
+	from, to := day.Period(fromPeriod), day.Period(toPeriod)
+	fromStart, fromEnd := periodWindow(fromPeriod, from)
+	toStart, toEnd := periodWindow(toPeriod, to)
+
+	if fromPeriod == toPeriod {
+		return (fromEnd - fromStart) / 2
+	}
+
+	gap := toStart - fromEnd
+	if gap < 0 {
+		gap = 0
+	}
+	if from.EndTime != "" && to.StartTime != "" {
+		return gap
+	}
+	return gap + (toEnd-toStart)/2
+}
+
+// periodWindow 활동의 시작/종료 시각 (자정 기준 분, 지정되지 않으면 export.PeriodSlots 사용)
+func periodWindow(name string, period *models.ActivityPeriod) (int, int) {
+	slot := export.PeriodSlots[name]
+	start, _ := models.ClockMinutes(slot.Start)
+	end, _ := models.ClockMinutes(slot.End)
+	if clock, ok := models.ClockMinutes(period.StartTime); ok {
+		length := end - start
+		start = clock
+		end = start + length
+	}
+	if clock, ok := models.ClockMinutes(period.EndTime); ok {
+		end = clock
+	}
+	if end <= start {
+		end += 24 * 60
+	}
+	return start, end
+}
+
+func findDay(data *models.TravelResponse, day int) *models.DayItinerary {
+	for i := range data.Itinerary {
+		if data.Itinerary[i].Day == day {
+			return &data.Itinerary[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package feasibility
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"tripwand-backend/internal/geo"
+	"tripwand-backend/internal/models"
+)
+
+func TestHaversine(t *testing.T) {
+	seoul := geo.Place{Name: "서울", Lat: 37.5665, Lng: 126.9780}
+	busan := geo.Place{Name: "부산", Lat: 35.1796, Lng: 129.0756}
+
+	tests := []struct {
+		name   string
+		a, b   geo.Place
+		wantKm float64
+		within float64
+	}{
+		{name: "same place", a: seoul, b: seoul, wantKm: 0, within: 0.001},
+		{name: "seoul to busan", a: seoul, b: busan, wantKm: 325, within: 3},
+		{name: "symmetric", a: busan, b: seoul, wantKm: 325, within: 3},
+		{name: "one degree of latitude", a: geo.Place{Lat: 0, Lng: 0}, b: geo.Place{Lat: 1, Lng: 0}, wantKm: 111.19, within: 0.01},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Haversine(tt.a, tt.b); math.Abs(got-tt.wantKm) > tt.within {
+				t.Errorf("Haversine = %.2f km, want %.2f ± %.2f", got, tt.wantKm, tt.within)
+			}
+		})
+	}
+}
+
+func TestTravelMinutes(t *testing.T) {
+	tests := []struct {
+		name       string
+		mode       string
+		distanceKm float64
+		want       int
+	}{
+		{name: "no distance", mode: "car", distanceKm: 0, want: 0},
+		{name: "walking", mode: "walking", distanceKm: 2, want: 35},                                // 2.6km / 4.5km/h
+		{name: "walking has no long distance speed", mode: "walking", distanceKm: 100, want: 1734}, // 130km / 4.5km/h
+		{name: "transit urban", mode: "transit", distanceKm: 12, want: 66},                         // 15 + 16.8km / 20km/h
+		{name: "transit long distance", mode: "transit", distanceKm: 100, want: 190},               // 15 + 40km / 20km/h + 100km / 110km/h
+		{name: "car urban", mode: "car", distanceKm: 12, want: 42},                                 // 10 + 15.6km / 30km/h
+		{name: "car long distance", mode: "car", distanceKm: 30, want: 65},                         // 10 + 20km / 30km/h + 19km / 80km/h
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Profiles[tt.mode].TravelMinutes(tt.distanceKm); got != tt.want {
+				t.Errorf("TravelMinutes(%.0f) = %d, want %d", tt.distanceKm, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTravelMinutesLongDistanceIsFaster(t *testing.T) {
+	p := Profiles["transit"]
+	urbanOnly := p
+	urbanOnly.LongDistanceKmh = 0
+
+	short := p.LongDistanceFromKm / p.DetourFactor / 2
+	if p.TravelMinutes(short) != urbanOnly.TravelMinutes(short) {
+		t.Errorf("below LongDistanceFromKm: got %d, want urban %d", p.TravelMinutes(short), urbanOnly.TravelMinutes(short))
+	}
+	if p.TravelMinutes(325) >= urbanOnly.TravelMinutes(325) {
+		t.Errorf("above LongDistanceFromKm: got %d, want less than urban %d", p.TravelMinutes(325), urbanOnly.TravelMinutes(325))
+	}
+}
+
+func TestPeriodWindow(t *testing.T) {
+	tests := []struct {
+		name      string
+		period    string
+		activity  models.ActivityPeriod
+		wantStart int
+		wantEnd   int
+	}{
+		{name: "default slot", period: "afternoon", wantStart: 13 * 60, wantEnd: 17 * 60},
+		{name: "start keeps slot length", period: "morning", activity: models.ActivityPeriod{StartTime: "10:00"}, wantStart: 10 * 60, wantEnd: 13 * 60},
+		{name: "explicit start and end", period: "evening", activity: models.ActivityPeriod{StartTime: "17:30", EndTime: "19:00"}, wantStart: 17*60 + 30, wantEnd: 19 * 60},
+		{name: "end past midnight", period: "night", activity: models.ActivityPeriod{EndTime: "01:00"}, wantStart: 20 * 60, wantEnd: 25 * 60},
+		{name: "start late keeps length past midnight", period: "night", activity: models.ActivityPeriod{StartTime: "23:00"}, wantStart: 23 * 60, wantEnd: 25 * 60},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := periodWindow(tt.period, &tt.activity)
+			if start != tt.wantStart || end != tt.wantEnd {
+				t.Errorf("periodWindow = (%d, %d), want (%d, %d)", start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestAllowedMinutes(t *testing.T) {
+	tests := []struct {
+		name string
+		day  models.DayItinerary
+		from string
+		to   string
+		want int
+	}{
+		{name: "same period", from: "morning", to: "morning", want: 90},
+		{name: "same period past midnight", day: models.DayItinerary{Night: models.ActivityPeriod{EndTime: "01:00"}}, from: "night", to: "night", want: 150},
+		{name: "next period", from: "morning", to: "afternoon", want: 180}, // 공백 60분 + 오후 절반 120분
+		{name: "adjacent periods", from: "evening", to: "night", want: 60}, // 공백 없음 + 밤 절반 60분
+		{name: "next start only", day: models.DayItinerary{Afternoon: models.ActivityPeriod{StartTime: "14:00"}}, from: "morning", to: "afternoon", want: 240},
+		{
+			name: "explicit end and start",
+			day:  models.DayItinerary{Morning: models.ActivityPeriod{EndTime: "11:00"}, Afternoon: models.ActivityPeriod{StartTime: "12:30"}},
+			from: "morning", to: "afternoon", want: 90,
+		},
+		{
+			name: "overlapping explicit times",
+			day:  models.DayItinerary{Morning: models.ActivityPeriod{EndTime: "13:30"}, Afternoon: models.ActivityPeriod{StartTime: "13:00"}},
+			from: "morning", to: "afternoon", want: 0,
+		},
+		{name: "overlapping end with default slot", day: models.DayItinerary{Morning: models.ActivityPeriod{EndTime: "13:30"}}, from: "morning", to: "afternoon", want: 120},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := allowedMinutes(&tt.day, tt.from, tt.to); got != tt.want {
+				t.Errorf("allowedMinutes(%s → %s) = %d, want %d", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	data := &models.TravelResponse{Itinerary: []models.DayItinerary{
+		{
+			Day:       1,
+			Morning:   models.ActivityPeriod{Summary: "경복궁 관람"},
+			Afternoon: models.ActivityPeriod{Summary: "광장시장 점심 후 해운대해수욕장 산책"},
+		},
+		{
+			// 도시 간 이동일은 검사하지 않음
+			Day:          2,
+			TransferFrom: "서울",
+			Morning:      models.ActivityPeriod{Summary: "감천문화마을"},
+			Afternoon:    models.ActivityPeriod{Summary: "경복궁"},
+		},
+		{
+			Day:     3,
+			Morning: models.ActivityPeriod{Summary: "명동 쇼핑"},
+			Evening: models.ActivityPeriod{Summary: "N서울타워 야경"},
+		},
+	}}
+
+	report := Check(data, "서울", geo.Builtin(), "teleport")
+
+	if report.Mode != DefaultMode {
+		t.Errorf("got mode %q, want fallback %q", report.Mode, DefaultMode)
+	}
+	// 1일차 2구간 + 3일차 1구간 (날짜가 바뀌는 이동과 2일차는 제외)
+	if report.Checked != 3 {
+		t.Errorf("checked %d transitions, want 3", report.Checked)
+	}
+	if len(report.Warnings) != 1 {
+		t.Fatalf("got warnings %+v, want 1", report.Warnings)
+	}
+
+	w := report.Warnings[0]
+	if w.Day != 1 || w.FromPeriod != "afternoon" || w.ToPeriod != "afternoon" || w.From != "광장시장" || w.To != "해운대해수욕장" {
+		t.Errorf("got warning %+v, want same-afternoon 광장시장 → 해운대해수욕장", w)
+	}
+	if w.AllowedMinutes != 120 || w.TravelMinutes <= w.AllowedMinutes {
+		t.Errorf("got %d travel minutes with %d allowed, want over 120", w.TravelMinutes, w.AllowedMinutes)
+	}
+	if report.Feasible() || !reflect.DeepEqual(report.FlaggedDays(), []int{1}) {
+		t.Errorf("got flagged days %v, want [1]", report.FlaggedDays())
+	}
+	if got := report.RepairInstructions(1); !strings.Contains(got, "광장시장") || !strings.Contains(got, "해운대해수욕장") {
+		t.Errorf("repair instructions %q do not name the flagged transition", got)
+	}
+	if got := report.RepairInstructions(3); got != "" {
+		t.Errorf("got repair instructions %q for unflagged day", got)
+	}
+}
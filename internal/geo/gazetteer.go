@@ -0,0 +1,174 @@
+// internal/geo/gazetteer.go
+package geo
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// entry 장소와 검색에 쓰는 이름 목록 (공식 이름 + 별칭)
+type entry struct {
+	Place
+	names      []string
+	keys       []string // 정규화한 이름과 한글 이름의 로마자 표기
+	keyNames   []string // keys[i]가 나온 원래 이름
+	population int64
+}
+
+// nameRef 추출 색인에 등록한 이름
+type nameRef struct {
+	needle string // 소문자로 바꾼 이름
+	entry  int    // entries 위치
+	short  bool   // foreignNameLength보다 짧은 이름 (목적지 밖 장소면 무시)
+}
+
+// Gazetteer 메모리에 올린 지명 사전
+type Gazetteer struct {
+	entries []entry
+	// index 이름의 앞 두 글자 → 그 글자로 시작하는 이름 (Extract는 텍스트 위치마다 이 후보만 비교)
+	index map[string][]nameRef
+}
+
+// Add 장소 추가 (aliases는 다른 표기나 줄임말, population은 동명 장소 순위에 사용)
+func (g *Gazetteer) Add(place Place, population int64, aliases ...string) {
+	e := entry{Place: place, population: population}
+	seen := make(map[string]bool)
+	for _, name := range append([]string{place.Name}, aliases...) {
+		name = strings.TrimSpace(name)
+		if utf8.RuneCountInString(name) < minNameLength || seen[name] {
+			continue
+		}
+		seen[name] = true
+		e.names = append(e.names, name)
+
+		key := Normalize(name)
+		e.keys = append(e.keys, key)
+		e.keyNames = append(e.keyNames, name)
+		if hasHangul(key) {
+			e.keys = append(e.keys, Romanize(key))
+			e.keyNames = append(e.keyNames, name)
+		}
+	}
+	if len(e.names) == 0 {
+		return
+	}
+	g.entries = append(g.entries, e)
+
+	if g.index == nil {
+		g.index = make(map[string][]nameRef)
+	}
+	for _, name := range e.names {
+		needle := strings.ToLower(name)
+		key, ok := indexKey(needle)
+		if !ok {
+			continue
+		}
+		g.index[key] = append(g.index[key], nameRef{
+			needle: needle,
+			entry:  len(g.entries) - 1,
+			short:  utf8.RuneCountInString(name) < foreignNameLength,
+		})
+	}
+}
+
+// indexKey 추출 색인 키 - 앞 두 글자 (두 글자보다 짧으면 false)
+func indexKey(s string) (string, bool) {
+	_, first := utf8.DecodeRuneInString(s)
+	if first == 0 || first >= len(s) {
+		return "", false
+	}
+	_, second := utf8.DecodeRuneInString(s[first:])
+	return s[:first+second], true
+}
+
+// Len 사전에 있는 장소 수
+func (g *Gazetteer) Len() int {
+	return len(g.entries)
+}
+
+// mention 텍스트에서 찾은 장소 이름의 위치
+type mention struct {
+	start, end int
+	entry      *entry
+	local      bool // 목적지 지역의 장소
+	order      int  // 사전에 추가된 순서 (동점일 때 먼저 추가된 장소 우선)
+}
+
+// Extract 텍스트에 언급된 장소를 등장 순서대로 추출 (같은 장소는 한 번만)
+// 겹치는 이름은 긴 이름을 우선하며, 목적지 지역의 장소를 다른 지역의 동명 장소보다 우선합니다
+// 텍스트의 글자 위치마다 색인에서 앞 두 글자가 같은 이름만 비교하므로 사전 크기와 무관하게 텍스트 길이에 비례합니다
+func (g *Gazetteer) Extract(text, destination string) []Place {
+	lower := strings.ToLower(text)
+
+	local := make(map[int]bool)
+	var mentions []mention
+	for start := 0; start < len(lower); {
+		if key, ok := indexKey(lower[start:]); ok {
+			for _, ref := range g.index[key] {
+				if !strings.HasPrefix(lower[start:], ref.needle) {
+					continue
+				}
+				e := &g.entries[ref.entry]
+				isLocal, cached := local[ref.entry]
+				if !cached {
+					isLocal = InRegion(destination, e.Region)
+					local[ref.entry] = isLocal
+				}
+				if !isLocal && ref.short {
+					continue
+				}
+				end := start + len(ref.needle)
+				// 영문 이름은 단어 일부("pseudo"의 "udo")와 구분
+				if isASCII(ref.needle) && !isWordBoundary(lower, start, end) {
+					continue
+				}
+				mentions = append(mentions, mention{start: start, end: end, entry: e, local: isLocal, order: ref.entry})
+			}
+		}
+		_, size := utf8.DecodeRuneInString(lower[start:])
+		start += size
+	}
+
+	// 긴 이름, 목적지 지역, 인구 순으로 먼저 자리를 차지
+	sort.SliceStable(mentions, func(i, j int) bool {
+		li, lj := mentions[i].end-mentions[i].start, mentions[j].end-mentions[j].start
+		if li != lj {
+			return li > lj
+		}
+		if mentions[i].local != mentions[j].local {
+			return mentions[i].local
+		}
+		if mentions[i].entry.population != mentions[j].entry.population {
+			return mentions[i].entry.population > mentions[j].entry.population
+		}
+		return mentions[i].order < mentions[j].order
+	})
+
+	var accepted []mention
+	for _, m := range mentions {
+		overlaps := false
+		for _, a := range accepted {
+			if m.start < a.end && a.start < m.end {
+				overlaps = true
+				break
+			}
+		}
+		if !overlaps {
+			accepted = append(accepted, m)
+		}
+	}
+	sort.Slice(accepted, func(i, j int) bool { return accepted[i].start < accepted[j].start })
+
+	var places []Place
+	seen := make(map[*entry]bool)
+	for _, m := range accepted {
+		if seen[m.entry] {
+			continue
+		}
+		seen[m.entry] = true
+		places = append(places, m.entry.Place)
+	}
+
+	return places
+}
@@ -0,0 +1,69 @@
+package geo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	g := Builtin()
+
+	tests := []struct {
+		name        string
+		text        string
+		destination string
+		want        []string
+	}{
+		{name: "order of appearance", text: "자갈치시장에서 점심 후 감천문화마을 산책", destination: "부산", want: []string{"자갈치시장", "감천문화마을"}},
+		{name: "alias inside longer word", text: "해운대에서 일출 보고 광안리 야경", destination: "부산", want: []string{"해운대해수욕장", "광안리해수욕장"}},
+		{name: "same place once", text: "해운대해수욕장 산책, 저녁에 다시 해운대", destination: "부산", want: []string{"해운대해수욕장"}},
+		{name: "longer name wins overlap", text: "롯데월드타워 전망대", destination: "서울", want: []string{"롯데월드타워"}},
+		{name: "short name outside destination ignored", text: "우도 땅콩 아이스크림", destination: "부산", want: nil},
+		{name: "short name inside destination", text: "우도 땅콩 아이스크림", destination: "제주", want: []string{"우도"}},
+		{name: "english name needs word boundary", text: "pseudo Udo trip", destination: "제주도", want: []string{"우도"}},
+		{name: "english name case-insensitive", text: "Walk along HAEUNDAE BEACH", destination: "Busan", want: []string{"해운대해수욕장"}},
+		{name: "no places", text: "호텔에서 휴식", destination: "서울", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, place := range g.Extract(tt.text, tt.destination) {
+				got = append(got, place.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Extract(%q, %q) = %v, want %v", tt.text, tt.destination, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractPrefersLocalSameName(t *testing.T) {
+	g := &Gazetteer{}
+	g.Add(Place{Name: "중앙공원", Region: "서울"}, 1000)
+	g.Add(Place{Name: "중앙공원", Region: "부산"}, 10)
+
+	places := g.Extract("중앙공원 산책", "부산")
+	if len(places) != 1 || places[0].Region != "부산" {
+		t.Errorf("got %+v, want the 부산 중앙공원", places)
+	}
+}
+
+func TestIndexKey(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+		ok   bool
+	}{
+		{text: "해운대", want: "해운", ok: true},
+		{text: "udo", want: "ud", ok: true},
+		{text: "해", ok: false},
+		{text: "", ok: false},
+	}
+	for _, tt := range tests {
+		got, ok := indexKey(tt.text)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("indexKey(%q) = %q, %v, want %q, %v", tt.text, got, ok, tt.want, tt.ok)
+		}
+	}
+}
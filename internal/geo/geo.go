@@ -0,0 +1,135 @@
+// internal/geo/geo.go
+package geo
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
+
+	"tripwand-backend/internal/geo/region"
+)
+
+//go:embed data/places.csv
+var defaultPlacesCSV []byte
+
+const (
+	// minNameLength 이보다 짧은 이름은 사전에 넣지 않음 (한 글자 지명은 오인이 많음)
+	minNameLength = 2
+	// foreignNameLength 목적지 밖 장소는 이 글자 수 이상의 이름만 인정 ("서면", "우도", "Udo" 같은 짧은 이름의 오인 방지)
+	foreignNameLength = 4
+)
+
+// Place 좌표가 있는 장소
+type Place struct {
+	Name    string  `json:"name"`
+	Region  string  `json:"region"`
+	Country string  `json:"country,omitempty"`
+	Lat     float64 `json:"lat"`
+	Lng     float64 `json:"lng"`
+}
+
+var defaultGazetteer atomic.Pointer[Gazetteer]
+
+func init() {
+	defaultGazetteer.Store(mustParseCSV(defaultPlacesCSV))
+}
+
+// Default 현재 사용 중인 지명 사전 (시작 시 내장 사전, DB에서 불러오면 교체)
+func Default() *Gazetteer {
+	return defaultGazetteer.Load()
+}
+
+// SetDefault 사용할 지명 사전 교체
+func SetDefault(g *Gazetteer) {
+	defaultGazetteer.Store(g)
+}
+
+// Builtin 내장 지명 사전의 복사본 (DB 지명을 더할 기반)
+func Builtin() *Gazetteer {
+	return mustParseCSV(defaultPlacesCSV)
+}
+
+// ParseCSV name,aliases,region,lat,lng 형식(별칭은 | 구분, 첫 줄은 헤더)의 지명 사전 읽기
+func ParseCSV(data []byte) (*Gazetteer, error) {
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read gazetteer: %w", err)
+	}
+
+	g := &Gazetteer{}
+	for i, record := range records {
+		if i == 0 {
+			continue
+		}
+		if len(record) != 5 {
+			return nil, fmt.Errorf("gazetteer line %d: expected 5 columns, got %d", i+1, len(record))
+		}
+		lat, err := strconv.ParseFloat(record[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("gazetteer line %d: invalid lat: %w", i+1, err)
+		}
+		lng, err := strconv.ParseFloat(record[4], 64)
+		if err != nil {
+			return nil, fmt.Errorf("gazetteer line %d: invalid lng: %w", i+1, err)
+		}
+
+		var aliases []string
+		if record[1] != "" {
+			aliases = strings.Split(record[1], "|")
+		}
+		g.Add(Place{Name: record[0], Region: record[2], Country: "KR", Lat: lat, Lng: lng}, 0, aliases...)
+	}
+
+	return g, nil
+}
+
+func mustParseCSV(data []byte) *Gazetteer {
+	g, err := ParseCSV(data)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// InRegion 목적지가 장소의 지역을 가리키는지 확인
+// 예: ("제주도", "제주"), ("Busan", "부산"), ("경주", "Gyeongsangbuk-do")
+func InRegion(destination, placeRegion string) bool {
+	if destination == "" || placeRegion == "" {
+		return false
+	}
+	dest, reg := strings.ToLower(destination), strings.ToLower(placeRegion)
+	if strings.Contains(dest, reg) || strings.Contains(reg, dest) {
+		return true
+	}
+
+	regionKeys := region.Keys(reg)
+	for key := range region.Keys(dest) {
+		if regionKeys[key] {
+			return true
+		}
+	}
+	return false
+}
+
+// isASCII 문자열이 ASCII 문자로만 이루어졌는지 확인
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// isWordBoundary text[start:end] 앞뒤가 영문자/숫자가 아닌지 확인
+func isWordBoundary(text string, start, end int) bool {
+	isWordByte := func(c byte) bool {
+		return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+	}
+	return (start == 0 || !isWordByte(text[start-1])) && (end == len(text) || !isWordByte(text[end]))
+}
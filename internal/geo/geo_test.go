@@ -0,0 +1,48 @@
+package geo
+
+import "testing"
+
+func TestInRegion(t *testing.T) {
+	tests := []struct {
+		destination string
+		region      string
+		want        bool
+	}{
+		{destination: "제주도", region: "제주", want: true},
+		{destination: "Busan", region: "부산", want: true},
+		{destination: "부산 해운대", region: "부산", want: true},
+		{destination: "서울", region: "부산", want: false},
+		{destination: "", region: "부산", want: false},
+		{destination: "부산", region: "", want: false},
+	}
+	for _, tt := range tests {
+		if got := InRegion(tt.destination, tt.region); got != tt.want {
+			t.Errorf("InRegion(%q, %q) = %v, want %v", tt.destination, tt.region, got, tt.want)
+		}
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	g, err := ParseCSV([]byte("name,aliases,region,lat,lng\n해운대해수욕장,해운대|Haeundae,부산,35.1587,129.1604\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Len() != 1 {
+		t.Fatalf("got %d places, want 1", g.Len())
+	}
+	places := g.Extract("haeundae 산책", "부산")
+	if len(places) != 1 || places[0].Country != "KR" || places[0].Lat != 35.1587 {
+		t.Errorf("got %+v, want 해운대해수욕장 found by alias", places)
+	}
+
+	invalid := []string{
+		"name,aliases,region,lat,lng\n해운대,,부산,35.1\n",
+		"name,aliases,region,lat,lng\n해운대,,부산,north,129.1\n",
+		"name,aliases,region,lat,lng\n해운대,,부산,35.1,east\n",
+	}
+	for _, data := range invalid {
+		if _, err := ParseCSV([]byte(data)); err == nil {
+			t.Errorf("expected error for %q", data)
+		}
+	}
+}
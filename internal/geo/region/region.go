@@ -0,0 +1,55 @@
+// internal/geo/region/region.go
+package region
+
+import (
+	"sort"
+	"strings"
+)
+
+// aliases 지역의 다른 표기 (소문자, 목적지와 장소 지역 비교용)
+// GeoNames의 1차 행정구역 이름도 포함해, 도 단위 지역은 대표 여행지로 묶습니다
+var aliases = map[string][]string{
+	"서울": {"seoul", "서울특별시"},
+	"부산": {"busan", "부산광역시"},
+	"인천": {"incheon", "인천광역시"},
+	"대구": {"daegu", "대구광역시"},
+	"대전": {"daejeon", "대전광역시"},
+	"광주": {"gwangju", "광주광역시"},
+	"울산": {"ulsan", "울산광역시"},
+	"제주": {"jeju", "제주특별자치도"},
+	"경주": {"gyeongju", "gyeongsangbuk-do", "north gyeongsang", "경상북도", "경북"},
+	"강릉": {"gangneung", "gangwon-do", "gangwon", "강원도", "강원특별자치도"},
+	"전주": {"jeonju", "jeollabuk-do", "north jeolla", "전라북도", "전북"},
+	"여수": {"yeosu", "jeollanam-do", "south jeolla", "전라남도", "전남"},
+	"통영": {"tongyeong", "gyeongsangnam-do", "south gyeongsang", "경상남도", "경남"},
+	"가평": {"gapyeong", "gyeonggi-do", "경기도"},
+}
+
+// Keys 소문자 문자열이 가리키는 대표 지역 이름들
+func Keys(text string) map[string]bool {
+	keys := make(map[string]bool)
+	for key, names := range aliases {
+		if strings.Contains(text, key) {
+			keys[key] = true
+			continue
+		}
+		for _, alias := range names {
+			if strings.Contains(text, alias) {
+				keys[key] = true
+				break
+			}
+		}
+	}
+	return keys
+}
+
+// Of 목적지가 가리키는 대표 지역 이름 (가나다순, 알 수 없으면 빈 목록)
+// 예: "제주도" → ["제주"], "Busan" → ["부산"]
+func Of(destination string) []string {
+	var regions []string
+	for key := range Keys(strings.ToLower(destination)) {
+		regions = append(regions, key)
+	}
+	sort.Strings(regions)
+	return regions
+}
@@ -0,0 +1,147 @@
+// internal/geo/resolve.go
+package geo
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// MinScore 이름 검색에서 후보로 인정하는 최소 유사도
+	MinScore = 0.7
+	// regionBoost 목적지 지역 장소의 순위 가산점
+	regionBoost = 0.1
+)
+
+// Match 이름 검색 결과
+type Match struct {
+	Place
+	Score       float64 `json:"score"`        // 0~1 유사도
+	MatchedName string  `json:"matched_name"` // 검색어와 가장 비슷했던 이름/별칭
+}
+
+// Resolve 이름과 비슷한 장소를 유사도 순으로 최대 limit개 반환
+// 한글/영문/로마자 표기를 서로 비교하며, 목적지 지역의 장소와 인구가 많은 장소를 우선합니다
+func (g *Gazetteer) Resolve(name, destination string, limit int) []Match {
+	query := Normalize(name)
+	if utf8.RuneCountInString(query) < minNameLength {
+		return nil
+	}
+	queries := []string{query}
+	if hasHangul(query) {
+		queries = append(queries, Romanize(query))
+	}
+
+	type ranked struct {
+		Match
+		rank       float64
+		population int64
+	}
+	var candidates []ranked
+	for i := range g.entries {
+		e := &g.entries[i]
+		best, matched := 0.0, ""
+		for k, key := range e.keys {
+			for _, q := range queries {
+				if score := similarity(q, key); score > best {
+					best, matched = score, e.keyNames[k]
+				}
+			}
+		}
+		if best < MinScore {
+			continue
+		}
+
+		rank := best
+		if InRegion(destination, e.Region) {
+			rank += regionBoost
+		}
+		candidates = append(candidates, ranked{
+			Match:      Match{Place: e.Place, Score: round(best), MatchedName: matched},
+			rank:       rank,
+			population: e.population,
+		})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].rank != candidates[j].rank {
+			return candidates[i].rank > candidates[j].rank
+		}
+		return candidates[i].population > candidates[j].population
+	})
+
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	matches := make([]Match, 0, len(candidates))
+	for _, candidate := range candidates {
+		matches = append(matches, candidate.Match)
+	}
+	return matches
+}
+
+// Normalize 비교용 이름 정규화 (소문자, 공백/기호 제거)
+// 예: "N Seoul Tower" → "nseoultower", "Hwangnidan-gil" → "hwangnidangil"
+func Normalize(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// similarity 정규화한 두 이름의 유사도 (0~1)
+// 한쪽이 다른 쪽을 포함하면 길이 비율로, 아니면 편집 거리로 계산합니다
+func similarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	ra, rb := []rune(a), []rune(b)
+	short, long := len(ra), len(rb)
+	if short > long {
+		short, long = long, short
+	}
+	if short == 0 {
+		return 0
+	}
+
+	if short >= minNameLength && (strings.Contains(a, b) || strings.Contains(b, a)) {
+		return 0.7 + 0.3*float64(short)/float64(long)
+	}
+
+	// 길이 차이만으로 기준에 못 미치면 편집 거리 계산 생략
+	if 1-float64(long-short)/float64(long) < MinScore {
+		return 0
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(long)
+}
+
+// levenshtein 두 문자열의 편집 거리 (문자 단위)
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// round 소수점 셋째 자리 반올림 (응답용)
+func round(v float64) float64 {
+	return float64(int(v*1000+0.5)) / 1000
+}
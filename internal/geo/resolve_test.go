@@ -0,0 +1,107 @@
+package geo
+
+import "testing"
+
+func TestResolve(t *testing.T) {
+	g := Builtin()
+
+	tests := []struct {
+		name        string
+		query       string
+		destination string
+		wantName    string // 첫 번째 후보 ("" 이면 후보 없음)
+		wantMatched string
+		wantScore   float64
+	}{
+		{name: "english alias", query: "Haeundae", destination: "부산", wantName: "해운대해수욕장", wantMatched: "해운대", wantScore: 1},
+		{name: "romanized hangul", query: "gyeongbokgung", wantName: "경복궁", wantMatched: "경복궁", wantScore: 1},
+		{name: "typo", query: "북촌한옥마울", destination: "서울", wantName: "북촌한옥마을", wantMatched: "북촌한옥마을", wantScore: 0.941},
+		{name: "exact name before longer name", query: "롯데월드", destination: "서울", wantName: "롯데월드", wantMatched: "롯데월드", wantScore: 1},
+		{name: "spaces and case ignored", query: "lotte world", destination: "서울", wantName: "롯데월드", wantMatched: "Lotte World", wantScore: 1},
+		{name: "partial english name", query: "Gamcheon Village", destination: "부산", wantName: "감천문화마을", wantMatched: "Gamcheon", wantScore: 0.86},
+		{name: "too short", query: "해", destination: "서울"},
+		{name: "no match", query: "xyzxyz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := g.Resolve(tt.query, tt.destination, 3)
+			if tt.wantName == "" {
+				if len(matches) != 0 {
+					t.Errorf("got %+v, want no matches", matches)
+				}
+				return
+			}
+			if len(matches) == 0 {
+				t.Fatalf("got no matches, want %s", tt.wantName)
+			}
+			got := matches[0]
+			if got.Name != tt.wantName || got.MatchedName != tt.wantMatched || got.Score != tt.wantScore {
+				t.Errorf("got %s (%s, %.3f), want %s (%s, %.3f)", got.Name, got.MatchedName, got.Score, tt.wantName, tt.wantMatched, tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestResolveRanking(t *testing.T) {
+	g := &Gazetteer{}
+	g.Add(Place{Name: "중앙공원", Region: "서울"}, 1000)
+	g.Add(Place{Name: "중앙공원", Region: "부산"}, 10)
+	g.Add(Place{Name: "중앙공원", Region: "대구"}, 100)
+
+	if matches := g.Resolve("중앙공원", "부산", 3); len(matches) != 3 || matches[0].Region != "부산" || matches[1].Region != "서울" {
+		t.Errorf("got %+v, want 부산 first then by population", matches)
+	}
+	if matches := g.Resolve("중앙공원", "", 1); len(matches) != 1 || matches[0].Region != "서울" {
+		t.Errorf("got %+v, want only the most populous", matches)
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{a: "seoul", b: "seoul", want: 1},
+		{a: "seoul", b: "seoultower", want: 0.85}, // 포함 관계는 길이 비율
+		{a: "abc", b: "abd", want: 0.667},         // 편집 거리 1
+		{a: "ab", b: "abcdefgh", want: 0.775},
+		{a: "a", b: "abcdefgh", want: 0}, // 한 글자 포함은 인정하지 않고 길이 차이로 생략
+		{a: "", b: "seoul", want: 0},
+	}
+	for _, tt := range tests {
+		if got := round(similarity(tt.a, tt.b)); got != tt.want {
+			t.Errorf("similarity(%q, %q) = %.3f, want %.3f", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "kitten", b: "sitting", want: 3},
+		{a: "", b: "abc", want: 3},
+		{a: "해운대", b: "해운대", want: 0},
+		{a: "북촌한옥마을", b: "북촌한옥마울", want: 1},
+	}
+	for _, tt := range tests {
+		if got := levenshtein([]rune(tt.a), []rune(tt.b)); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := map[string]string{
+		"N Seoul Tower":  "nseoultower",
+		"Hwangnidan-gil": "hwangnidangil",
+		" 해운대 해수욕장 ":     "해운대해수욕장",
+	}
+	for name, want := range tests {
+		if got := Normalize(name); got != want {
+			t.Errorf("Normalize(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// internal/geo/romanize.go
+package geo
+
+import "strings"
+
+// 한글 음절 분해용 상수 (유니코드 한글 음절 블록)
+const (
+	hangulBase   = 0xAC00
+	hangulLast   = 0xD7A3
+	medialCount  = 21
+	finalCount   = 28
+	syllableSpan = medialCount * finalCount
+)
+
+// 국어의 로마자 표기법 기준 자모 표기 (발음 동화는 반영하지 않음)
+var (
+	romanInitials = []string{"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s", "ss", "", "j", "jj", "ch", "k", "t", "p", "h"}
+	romanMedials  = []string{"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i"}
+	romanFinals   = []string{"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l", "l", "l", "p", "l", "m", "p", "p", "t", "t", "ng", "t", "t", "k", "t", "p", "t"}
+)
+
+// Romanize 한글을 로마자로 변환 (한글이 아닌 문자는 그대로 유지)
+// 예: "경복궁" → "gyeongbokgung", "해운대" → "haeundae"
+func Romanize(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		if r < hangulBase || r > hangulLast {
+			b.WriteRune(r)
+			continue
+		}
+		index := int(r - hangulBase)
+		b.WriteString(romanInitials[index/syllableSpan])
+		b.WriteString(romanMedials[(index%syllableSpan)/finalCount])
+		b.WriteString(romanFinals[index%finalCount])
+	}
+	return b.String()
+}
+
+// hasHangul 한글 음절이 포함되어 있는지 확인
+func hasHangul(text string) bool {
+	for _, r := range text {
+		if r >= hangulBase && r <= hangulLast {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,184 @@
+// internal/geo/store.go
+package geo
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"tripwand-backend/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// importBatchSize 가져오기 시 한 번에 저장하는 행 수
+const importBatchSize = 1000
+
+// geonamesColumns GeoNames 덤프(탭 구분)의 열 수
+const geonamesColumns = 19
+
+// ImportOptions GeoNames 덤프 가져오기 옵션
+type ImportOptions struct {
+	Countries      map[string]bool   // 가져올 국가 코드 (비어 있으면 전체)
+	FeatureClasses map[string]bool   // 가져올 분류 (P: 도시/마을, S: 건물/명소, L: 공원/지역, T: 산, H: 물가 - 비어 있으면 전체)
+	Admin1Names    map[string]string // "KR.10" → "Busan" (admin1CodesASCII.txt)
+}
+
+// ImportGeoNames GeoNames 형식 덤프를 geo_places 테이블에 가져오기 (geoname_id 기준 upsert)
+// 가져온 행 수를 반환합니다
+func ImportGeoNames(db *gorm.DB, r io.Reader, opts ImportOptions) (int, error) {
+	scanner := bufio.NewScanner(r)
+	// alternatenames 열이 매우 긴 행이 있음
+	scanner.Buffer(make([]byte, 1024*1024), 16*1024*1024)
+
+	var batch []models.GeoPlace
+	imported := 0
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := db.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "geoname_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"name", "name_ko", "alt_names", "region", "country_code", "feature_code", "lat", "lng", "population", "updated_at",
+			}),
+		}).Create(&batch).Error; err != nil {
+			return err
+		}
+		imported += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		cols := strings.Split(scanner.Text(), "\t")
+		if len(cols) < geonamesColumns {
+			return imported, fmt.Errorf("geonames line %d: expected %d columns, got %d", line, geonamesColumns, len(cols))
+		}
+
+		country, class := cols[8], cols[6]
+		if len(opts.Countries) > 0 && !opts.Countries[country] {
+			continue
+		}
+		if len(opts.FeatureClasses) > 0 && !opts.FeatureClasses[class] {
+			continue
+		}
+
+		place, err := parseGeoNamesRow(cols, opts.Admin1Names)
+		if err != nil {
+			return imported, fmt.Errorf("geonames line %d: %w", line, err)
+		}
+		batch = append(batch, *place)
+
+		if len(batch) >= importBatchSize {
+			if err := flush(); err != nil {
+				return imported, err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return imported, fmt.Errorf("failed to read geonames dump: %w", err)
+	}
+
+	return imported, flush()
+}
+
+// parseGeoNamesRow GeoNames 한 행을 지명 사전 항목으로 변환
+// 다른 표기 중 검색에 쓰는 한글/영문 표기만 남기고, 첫 한글 표기를 한국어 이름으로 사용합니다
+func parseGeoNamesRow(cols []string, admin1Names map[string]string) (*models.GeoPlace, error) {
+	geonameID, err := strconv.ParseInt(cols[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid geonameid: %w", err)
+	}
+	lat, err := strconv.ParseFloat(cols[4], 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid latitude: %w", err)
+	}
+	lng, err := strconv.ParseFloat(cols[5], 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid longitude: %w", err)
+	}
+	population, _ := strconv.ParseInt(cols[14], 10, 64)
+
+	place := &models.GeoPlace{
+		GeonameID:   geonameID,
+		Name:        cols[1],
+		Region:      admin1Names[cols[8]+"."+cols[10]],
+		CountryCode: cols[8],
+		FeatureCode: cols[7],
+		Lat:         lat,
+		Lng:         lng,
+		Population:  population,
+	}
+
+	var altNames []string
+	seen := map[string]bool{cols[1]: true}
+	for _, name := range append([]string{cols[2]}, strings.Split(cols[3], ",")...) {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] || !(hasHangul(name) || isASCII(name)) {
+			continue
+		}
+		seen[name] = true
+		if place.NameKo == "" && hasHangul(name) {
+			place.NameKo = name
+		}
+		altNames = append(altNames, name)
+	}
+	place.AltNames = strings.Join(altNames, "|")
+
+	return place, nil
+}
+
+// ParseAdmin1Codes GeoNames admin1CodesASCII.txt 읽기 ("KR.10" → "Busan")
+func ParseAdmin1Codes(r io.Reader) (map[string]string, error) {
+	names := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		cols := strings.Split(scanner.Text(), "\t")
+		if len(cols) < 3 {
+			continue
+		}
+		// 영문(ASCII) 이름 사용
+		names[cols[0]] = cols[2]
+	}
+	return names, scanner.Err()
+}
+
+// LoadFromDB 내장 지명 사전에 geo_places 테이블의 장소를 더한 지명 사전 생성
+func LoadFromDB(db *gorm.DB) (*Gazetteer, error) {
+	g := Builtin()
+
+	var rows []models.GeoPlace
+	err := db.Model(&models.GeoPlace{}).
+		Select("id", "name", "name_ko", "alt_names", "region", "country_code", "lat", "lng", "population").
+		FindInBatches(&rows, 5000, func(tx *gorm.DB, batch int) error {
+			for _, row := range rows {
+				name := row.Name
+				aliases := []string{row.Name}
+				if row.NameKo != "" {
+					name = row.NameKo
+				}
+				if row.AltNames != "" {
+					aliases = append(aliases, strings.Split(row.AltNames, "|")...)
+				}
+				g.Add(Place{
+					Name:    name,
+					Region:  row.Region,
+					Country: row.CountryCode,
+					Lat:     row.Lat,
+					Lng:     row.Lng,
+				}, row.Population, aliases...)
+			}
+			return nil
+		}).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to load gazetteer: %w", err)
+	}
+
+	return g, nil
+}
@@ -0,0 +1,38 @@
+// internal/jobs/purge.go
+package jobs
+
+import (
+	"log"
+	"time"
+
+	"tripwand-backend/internal/database"
+	"tripwand-backend/internal/models"
+)
+
+// StartPlanPurgeJob 휴지통 보관 기간이 지난 여행 계획을 주기적으로 영구 삭제
+func StartPlanPurgeJob(interval, retention time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			purgeDeletedPlans(retention)
+			<-ticker.C
+		}
+	}()
+}
+
+// purgeDeletedPlans 보관 기간이 지난 계획 영구 삭제 1회 실행
+func purgeDeletedPlans(retention time.Duration) {
+	cutoff := time.Now().Add(-retention)
+
+	purged, err := models.PurgeDeletedPlans(database.DB, cutoff)
+	if err != nil {
+		log.Printf("⚠️ Failed to purge deleted travel plans: %v", err)
+		return
+	}
+
+	if purged > 0 {
+		log.Printf("🗑️ Purged %d travel plans deleted before %s", purged, cutoff.Format(time.RFC3339))
+	}
+}
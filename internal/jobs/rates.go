@@ -0,0 +1,33 @@
+// internal/jobs/rates.go
+package jobs
+
+import (
+	"log"
+	"time"
+
+	"tripwand-backend/internal/currency"
+	"tripwand-backend/internal/database"
+)
+
+// StartRatesRefreshJob 업로드된 최신 환율표를 주기적으로 다시 불러옴
+// 환율표 업로드는 요청을 처리한 인스턴스에만 바로 반영되므로, 다른 인스턴스는 이 작업으로 따라잡습니다
+func StartRatesRefreshJob(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			refreshRates()
+		}
+	}()
+}
+
+// refreshRates 환율표 갱신 1회 실행 (현재 환율표보다 기준일이 이르면 유지)
+func refreshRates() {
+	table, err := currency.LoadFromDB(database.DB)
+	if err != nil {
+		log.Printf("⚠️ Failed to refresh exchange rates: %v", err)
+		return
+	}
+	currency.SetDefault(currency.Newer(currency.Default(), table))
+}
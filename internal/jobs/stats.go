@@ -0,0 +1,31 @@
+// internal/jobs/stats.go
+package jobs
+
+import (
+	"log"
+	"time"
+
+	"tripwand-backend/internal/database"
+	"tripwand-backend/internal/stats"
+)
+
+// StartStatsRefreshJob 여행 통계 스냅샷을 주기적으로 다시 계산
+// /travel/stats 요청은 매번 집계하지 않고 이 스냅샷을 반환합니다
+func StartStatsRefreshJob(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			refreshStats()
+			<-ticker.C
+		}
+	}()
+}
+
+// refreshStats 통계 스냅샷 갱신 1회 실행
+func refreshStats() {
+	if _, err := stats.Refresh(database.DB); err != nil {
+		log.Printf("⚠️ Failed to refresh travel stats: %v", err)
+	}
+}
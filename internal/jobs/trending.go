@@ -0,0 +1,30 @@
+// internal/jobs/trending.go
+package jobs
+
+import (
+	"log"
+	"time"
+
+	"tripwand-backend/internal/database"
+	"tripwand-backend/internal/models"
+)
+
+// StartTrendingRefreshJob 여행 계획의 trending_score를 주기적으로 재계산
+func StartTrendingRefreshJob(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			refreshTrendingScores()
+			<-ticker.C
+		}
+	}()
+}
+
+// refreshTrendingScores 트렌딩 점수 재계산 1회 실행
+func refreshTrendingScores() {
+	if err := models.RefreshTrendingScores(database.DB); err != nil {
+		log.Printf("⚠️ Failed to refresh trending scores: %v", err)
+	}
+}
@@ -0,0 +1,29 @@
+// internal/jobs/views.go
+package jobs
+
+import (
+	"log"
+	"time"
+
+	"tripwand-backend/internal/database"
+	"tripwand-backend/internal/views"
+)
+
+// StartViewFlushJob 버퍼링된 조회수를 주기적으로 데이터베이스에 일괄 반영
+func StartViewFlushJob(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			FlushViews()
+		}
+	}()
+}
+
+// FlushViews 조회수 일괄 반영 1회 실행 (서버 종료 시에도 호출해 버퍼에 남은 조회수 반영)
+func FlushViews() {
+	if err := views.DefaultCounter.Flush(database.DB, time.Now()); err != nil {
+		log.Printf("⚠️ Failed to flush plan views: %v", err)
+	}
+}
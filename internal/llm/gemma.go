@@ -76,24 +76,7 @@ func NewGemmaClient() (*GemmaClient, error) {
 	model.SetTopK(40)
 
 	// 안전 설정 (한국어 콘텐츠 지원)
-	model.SafetySettings = []*genai.SafetySetting{
-		{
-			Category:  genai.HarmCategoryHarassment,
-			Threshold: genai.HarmBlockMediumAndAbove,
-		},
-		{
-			Category:  genai.HarmCategoryHateSpeech,
-			Threshold: genai.HarmBlockMediumAndAbove,
-		},
-		{
-			Category:  genai.HarmCategorySexuallyExplicit,
-			Threshold: genai.HarmBlockMediumAndAbove,
-		},
-		{
-			Category:  genai.HarmCategoryDangerousContent,
-			Threshold: genai.HarmBlockMediumAndAbove,
-		},
-	}
+	model.SafetySettings = safetySettings()
 
 	return &GemmaClient{
 		client: client,
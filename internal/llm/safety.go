@@ -0,0 +1,135 @@
+// internal/llm/safety.go
+package llm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/generative-ai-go/genai"
+)
+
+// safetyCategory 모델에 적용하는 안전 카테고리와 외부에 노출하는 이름
+type safetyCategory struct {
+	Category genai.HarmCategory
+	Name     string
+}
+
+// safetyCategories NewGemmaClient에서 차단 기준으로 사용하는 안전 카테고리
+var safetyCategories = []safetyCategory{
+	{Category: genai.HarmCategoryHarassment, Name: "harassment"},
+	{Category: genai.HarmCategoryHateSpeech, Name: "hate_speech"},
+	{Category: genai.HarmCategorySexuallyExplicit, Name: "sexually_explicit"},
+	{Category: genai.HarmCategoryDangerousContent, Name: "dangerous_content"},
+}
+
+// SafetyResult 안전 분류 결과
+type SafetyResult struct {
+	Flagged    bool     `json:"flagged"`
+	Categories []string `json:"categories"`
+	Reason     string   `json:"reason"`
+}
+
+// safetySettings 안전 카테고리별 차단 설정 (중간 이상 위험 차단)
+func safetySettings() []*genai.SafetySetting {
+	settings := make([]*genai.SafetySetting, 0, len(safetyCategories))
+	for _, category := range safetyCategories {
+		settings = append(settings, &genai.SafetySetting{
+			Category:  category.Category,
+			Threshold: genai.HarmBlockMediumAndAbove,
+		})
+	}
+	return settings
+}
+
+// SafetyCategoryNames 안전 카테고리 이름 목록
+func SafetyCategoryNames() []string {
+	names := make([]string, 0, len(safetyCategories))
+	for _, category := range safetyCategories {
+		names = append(names, category.Name)
+	}
+	return names
+}
+
+// ClassifySafety 텍스트가 안전 카테고리에 해당하는지 분류
+// 모델이 안전 설정으로 요청/응답을 차단하면 차단된 카테고리로 판정합니다
+func (g *GemmaClient) ClassifySafety(text string) (*SafetyResult, error) {
+	tempModel := g.client.GenerativeModel("gemma-3-27b-it")
+	tempModel.SafetySettings = safetySettings()
+	tempModel.SetTemperature(0)
+	tempModel.SetMaxOutputTokens(200)
+
+	prompt := fmt.Sprintf(`당신은 여행 커뮤니티의 콘텐츠 검수자입니다.
+아래 텍스트가 다음 안전 카테고리 중 하나 이상에 해당하는지 판단하세요: %s
+
+다음 JSON 형식으로 정확히 답변해주세요. 다른 설명이나 부가 텍스트 없이 오직 JSON만 반환하세요:
+
+{"flagged": true 또는 false, "categories": ["해당 카테고리"], "reason": "판단 이유 한 문장"}
+
+텍스트:
+"""
+%s
+"""`, strings.Join(SafetyCategoryNames(), ", "), text)
+
+	resp, err := tempModel.GenerateContent(g.ctx, genai.Text(prompt))
+	if err != nil {
+		var blocked *genai.BlockedError
+		if errors.As(err, &blocked) {
+			return blockedSafetyResult(blocked), nil
+		}
+		return nil, fmt.Errorf("failed to classify content: %w", err)
+	}
+
+	if len(resp.Candidates) == 0 || resp.Candidates[0].Content == nil {
+		return nil, fmt.Errorf("no classification generated")
+	}
+
+	responseText := ""
+	for _, part := range resp.Candidates[0].Content.Parts {
+		if text, ok := part.(genai.Text); ok {
+			responseText += string(text)
+		}
+	}
+
+	start, end := strings.Index(responseText, "{"), strings.LastIndex(responseText, "}")
+	if start == -1 || end <= start {
+		return nil, fmt.Errorf("invalid classification response: %s", responseText)
+	}
+
+	var result SafetyResult
+	if err := json.Unmarshal([]byte(responseText[start:end+1]), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse classification: %w", err)
+	}
+	result.Flagged = result.Flagged || len(result.Categories) > 0
+
+	return &result, nil
+}
+
+// blockedSafetyResult 안전 설정으로 차단된 경우의 분류 결과
+func blockedSafetyResult(blocked *genai.BlockedError) *SafetyResult {
+	var ratings []*genai.SafetyRating
+	if blocked.PromptFeedback != nil {
+		ratings = append(ratings, blocked.PromptFeedback.SafetyRatings...)
+	}
+	if blocked.Candidate != nil {
+		ratings = append(ratings, blocked.Candidate.SafetyRatings...)
+	}
+
+	result := &SafetyResult{
+		Flagged: true,
+		Reason:  blocked.Error(),
+	}
+	for _, rating := range ratings {
+		if !rating.Blocked && rating.Probability < genai.HarmProbabilityMedium {
+			continue
+		}
+		for _, category := range safetyCategories {
+			if category.Category == rating.Category {
+				result.Categories = append(result.Categories, category.Name)
+			}
+		}
+	}
+
+	return result
+}
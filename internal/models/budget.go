@@ -0,0 +1,213 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// BudgetModeTarget 예산을 목표로 참고만 함 (기본값)
+	BudgetModeTarget = "target"
+	// BudgetModeStayUnder 예상 비용이 예산을 넘으면 한 번 더 생성해 예산 안으로 맞춤
+	BudgetModeStayUnder = "stay_under"
+)
+
+// BudgetCurrency 예산 금액의 통화
+const BudgetCurrency = "KRW"
+
+// BudgetCategories 예산 항목 이름 (BudgetItems 필드 순서)
+var BudgetCategories = []string{"lodging", "food", "transport", "activities"}
+
+// BudgetModes 지원하는 예산 모드
+var BudgetModes = map[string]bool{
+	BudgetModeTarget:    true,
+	BudgetModeStayUnder: true,
+}
+
+// BudgetItems 항목별 비용 (1인 기준 원화)
+type BudgetItems struct {
+	Lodging    int `json:"lodging" example:"80000"`
+	Food       int `json:"food" example:"50000"`
+	Transport  int `json:"transport" example:"20000"`
+	Activities int `json:"activities" example:"30000"`
+}
+
+// Sum 항목 합계
+func (bi BudgetItems) Sum() int {
+	return bi.Lodging + bi.Food + bi.Transport + bi.Activities
+}
+
+// add 항목별로 더함
+func (bi BudgetItems) add(other BudgetItems) BudgetItems {
+	return BudgetItems{
+		Lodging:    bi.Lodging + other.Lodging,
+		Food:       bi.Food + other.Food,
+		Transport:  bi.Transport + other.Transport,
+		Activities: bi.Activities + other.Activities,
+	}
+}
+
+// clamped 음수 항목을 0으로 바꾼 값
+func (bi BudgetItems) clamped() BudgetItems {
+	return BudgetItems{
+		Lodging:    max(bi.Lodging, 0),
+		Food:       max(bi.Food, 0),
+		Transport:  max(bi.Transport, 0),
+		Activities: max(bi.Activities, 0),
+	}
+}
+
+// negative 음수 항목 이름 (없으면 "")
+func (bi BudgetItems) negative() string {
+	values := []int{bi.Lodging, bi.Food, bi.Transport, bi.Activities}
+	for i, value := range values {
+		if value < 0 {
+			return BudgetCategories[i]
+		}
+	}
+	return ""
+}
+
+// DayBudget 하루 비용 (1인 기준)
+type DayBudget struct {
+	Day int `json:"day" example:"1"`
+	BudgetItems
+	Total int `json:"total" example:"180000"`
+}
+
+// BudgetBreakdown 항목별/일별 예산 내역
+// 모든 항목은 1인 기준이며, Total만 인원수를 곱한 전체 금액입니다
+type BudgetBreakdown struct {
+	Currency       string      `json:"currency" example:"KRW"`
+	Days           []DayBudget `json:"days"`
+	PerPerson      BudgetItems `json:"per_person"`                        // 항목별 1인 합계
+	PerPersonTotal int         `json:"per_person_total" example:"540000"` // estimated_cost와 같음
+	GroupSize      int         `json:"group_size" example:"2"`
+	Total          int         `json:"total" example:"1080000"`
+	Limit          int         `json:"limit,omitempty" example:"600000"` // 요청한 1인 예산
+	OverBudget     bool        `json:"over_budget,omitempty"`
+}
+
+// Reconcile 일별 항목으로 합계를 다시 계산하고 일정 일수에 맞춤
+// AI가 계산한 합계는 틀릴 수 있으므로 항목 값만 신뢰하며, 음수 항목은 합계를 줄이지 않도록 0으로 봅니다
+func (tr *TravelResponse) Reconcile(groupSize, limit int) {
+	if tr.Budget == nil {
+		return
+	}
+	b := tr.Budget
+
+	byDay := make(map[int]BudgetItems, len(b.Days))
+	for _, day := range b.Days {
+		byDay[day.Day] = day.BudgetItems.clamped()
+	}
+
+	b.Days = make([]DayBudget, 0, len(tr.Itinerary))
+	b.PerPerson = BudgetItems{}
+	for _, day := range tr.Itinerary {
+		items := byDay[day.Day]
+		b.Days = append(b.Days, DayBudget{Day: day.Day, BudgetItems: items, Total: items.Sum()})
+		b.PerPerson = b.PerPerson.add(items)
+	}
+
+	// 항목 값을 하나도 주지 않았으면 내역 없이 estimated_cost만 사용
+	if b.PerPerson.Sum() == 0 {
+		tr.Budget = nil
+		return
+	}
+
+	if groupSize < 1 {
+		groupSize = 1
+	}
+	b.Currency = BudgetCurrency
+	b.PerPersonTotal = b.PerPerson.Sum()
+	b.GroupSize = groupSize
+	b.Total = b.PerPersonTotal * groupSize
+	b.Limit = limit
+	b.OverBudget = limit > 0 && b.PerPersonTotal > limit
+
+	tr.EstimatedCost = b.PerPersonTotal
+}
+
+// validateBudget 예산 내역의 합계가 항목 합과 일치하는지 검증
+func (tr *TravelResponse) validateBudget() error {
+	b := tr.Budget
+	if b == nil {
+		return nil
+	}
+
+	if len(b.Days) != len(tr.Itinerary) {
+		return fmt.Errorf("budget must have %d days, got %d", len(tr.Itinerary), len(b.Days))
+	}
+
+	var perPerson BudgetItems
+	for i, day := range b.Days {
+		if day.Day != i+1 {
+			return fmt.Errorf("budget day %d has day number %d, days must be sequential from 1", i+1, day.Day)
+		}
+		if name := day.negative(); name != "" {
+			return fmt.Errorf("budget day %d %s must not be negative", day.Day, name)
+		}
+		if day.Total != day.Sum() {
+			return fmt.Errorf("budget day %d total %d does not match item sum %d", day.Day, day.Total, day.Sum())
+		}
+		perPerson = perPerson.add(day.BudgetItems)
+	}
+
+	if b.PerPerson != perPerson {
+		return fmt.Errorf("budget per_person does not match the sum of daily items")
+	}
+	if b.PerPersonTotal != perPerson.Sum() {
+		return fmt.Errorf("budget per_person_total %d does not match item sum %d", b.PerPersonTotal, perPerson.Sum())
+	}
+	if b.GroupSize < 1 || b.Total != b.PerPersonTotal*b.GroupSize {
+		return fmt.Errorf("budget total %d does not match per_person_total × group_size", b.Total)
+	}
+	if tr.EstimatedCost != b.PerPersonTotal {
+		return fmt.Errorf("estimated_cost %d does not match budget per_person_total %d", tr.EstimatedCost, b.PerPersonTotal)
+	}
+
+	return nil
+}
+
+// budgetPrompt 예산 조건 안내 문구 (예산을 지정하지 않았으면 "")
+func (tr *TravelRequest) budgetPrompt() string {
+	if tr.Budget == nil || *tr.Budget <= 0 {
+		return ""
+	}
+
+	if tr.BudgetModeOrDefault() == BudgetModeStayUnder {
+		return fmt.Sprintf("1인 예산은 %s원입니다. 전체 예상 비용(1인 기준)이 절대 예산을 넘지 않도록 숙소, 식사, 교통, 활동을 구성해주세요.", FormatThousands(*tr.Budget))
+	}
+	return fmt.Sprintf("1인 예산은 %s원 정도입니다. 예산에 맞는 숙소, 식사, 교통, 활동을 추천해주세요.", FormatThousands(*tr.Budget))
+}
+
+// BudgetModeOrDefault 예산 모드 (미지정 시 target)
+func (tr *TravelRequest) BudgetModeOrDefault() string {
+	return getStringValue(tr.BudgetMode, BudgetModeTarget)
+}
+
+// BudgetRetryPrompt 예산을 넘은 일정을 다시 생성할 때 덧붙이는 문구
+func BudgetRetryPrompt(estimated, limit int) string {
+	return fmt.Sprintf("\n\n이전에 만든 일정은 1인 예상 비용이 %s원으로 예산 %s원을 넘었습니다. 숙소 등급을 낮추거나 무료 관광지와 대중교통을 활용해 1인 비용이 %s원 이하가 되도록 다시 만들어주세요.",
+		FormatThousands(estimated), FormatThousands(limit), FormatThousands(limit))
+}
+
+// FormatThousands 세 자리마다 쉼표를 넣은 숫자 (예: 1,200,000) - 프롬프트와 내보내기 문서의 금액 표기에 사용
+func FormatThousands(amount int) string {
+	digits := fmt.Sprintf("%d", amount)
+	if amount < 0 {
+		digits = digits[1:]
+	}
+
+	var b strings.Builder
+	if amount < 0 {
+		b.WriteByte('-')
+	}
+	for i, r := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,221 @@
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+// budgetResponse 일수만큼의 일정과 AI가 준 예산 내역
+func budgetResponse(days int, budget *BudgetBreakdown) *TravelResponse {
+	tr := &TravelResponse{Budget: budget}
+	for day := 1; day <= days; day++ {
+		tr.Itinerary = append(tr.Itinerary, testDay(day, "a", "b", "c", "d"))
+	}
+	return tr
+}
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		name           string
+		days           int
+		budget         *BudgetBreakdown
+		groupSize      int
+		limit          int
+		wantNil        bool
+		wantDayTotals  []int
+		wantPerPerson  int
+		wantTotal      int
+		wantOverBudget bool
+	}{
+		{
+			name: "recomputes wrong totals",
+			days: 2,
+			budget: &BudgetBreakdown{
+				Days: []DayBudget{
+					{Day: 1, BudgetItems: BudgetItems{Lodging: 80000, Food: 40000}, Total: 999},
+					{Day: 2, BudgetItems: BudgetItems{Food: 30000, Transport: 20000}, Total: 0},
+				},
+				PerPersonTotal: 1,
+				Total:          1,
+			},
+			groupSize:     2,
+			wantDayTotals: []int{120000, 50000},
+			wantPerPerson: 170000,
+			wantTotal:     340000,
+		},
+		{
+			name: "fills missing days and drops extra days",
+			days: 3,
+			budget: &BudgetBreakdown{
+				Days: []DayBudget{
+					{Day: 1, BudgetItems: BudgetItems{Food: 10000}},
+					{Day: 3, BudgetItems: BudgetItems{Food: 30000}},
+					{Day: 4, BudgetItems: BudgetItems{Food: 40000}},
+				},
+			},
+			groupSize:     1,
+			wantDayTotals: []int{10000, 0, 30000},
+			wantPerPerson: 40000,
+			wantTotal:     40000,
+		},
+		{
+			name: "negative items do not lower totals",
+			days: 2,
+			budget: &BudgetBreakdown{
+				Days: []DayBudget{
+					{Day: 1, BudgetItems: BudgetItems{Lodging: 150000, Food: -60000}},
+					{Day: 2, BudgetItems: BudgetItems{Transport: -20000, Activities: 10000}},
+				},
+			},
+			groupSize:      1,
+			limit:          120000,
+			wantDayTotals:  []int{150000, 10000},
+			wantPerPerson:  160000,
+			wantTotal:      160000,
+			wantOverBudget: true,
+		},
+		{
+			name:    "only negative items",
+			days:    1,
+			budget:  &BudgetBreakdown{Days: []DayBudget{{Day: 1, BudgetItems: BudgetItems{Food: -1000}}}},
+			wantNil: true,
+		},
+		{
+			name:          "group size below one counts as one",
+			days:          1,
+			budget:        &BudgetBreakdown{Days: []DayBudget{{Day: 1, BudgetItems: BudgetItems{Activities: 5000}}}},
+			groupSize:     0,
+			wantDayTotals: []int{5000},
+			wantPerPerson: 5000,
+			wantTotal:     5000,
+		},
+		{
+			name:           "over the per-person limit",
+			days:           1,
+			budget:         &BudgetBreakdown{Days: []DayBudget{{Day: 1, BudgetItems: BudgetItems{Lodging: 150000}}}},
+			groupSize:      3,
+			limit:          100000,
+			wantDayTotals:  []int{150000},
+			wantPerPerson:  150000,
+			wantTotal:      450000,
+			wantOverBudget: true,
+		},
+		{
+			name:    "no item values drops the breakdown",
+			days:    2,
+			budget:  &BudgetBreakdown{Days: []DayBudget{{Day: 1, Total: 50000}}, PerPersonTotal: 50000},
+			wantNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := budgetResponse(tt.days, tt.budget)
+			tr.EstimatedCost = 123
+			tr.Reconcile(tt.groupSize, tt.limit)
+
+			if tt.wantNil {
+				if tr.Budget != nil {
+					t.Fatalf("got budget %+v, want nil", tr.Budget)
+				}
+				if tr.EstimatedCost != 123 {
+					t.Errorf("estimated_cost changed to %d", tr.EstimatedCost)
+				}
+				return
+			}
+
+			b := tr.Budget
+			if len(b.Days) != len(tt.wantDayTotals) {
+				t.Fatalf("got %d days, want %d", len(b.Days), len(tt.wantDayTotals))
+			}
+			for i, day := range b.Days {
+				if day.Day != i+1 || day.Total != tt.wantDayTotals[i] {
+					t.Errorf("day %d: got day %d total %d, want total %d", i+1, day.Day, day.Total, tt.wantDayTotals[i])
+				}
+			}
+			if b.PerPersonTotal != tt.wantPerPerson || b.Total != tt.wantTotal || tr.EstimatedCost != tt.wantPerPerson {
+				t.Errorf("got per person %d total %d estimated %d, want %d %d", b.PerPersonTotal, b.Total, tr.EstimatedCost, tt.wantPerPerson, tt.wantTotal)
+			}
+			if b.OverBudget != tt.wantOverBudget || b.Limit != tt.limit || b.Currency != BudgetCurrency {
+				t.Errorf("got over %v limit %d currency %q", b.OverBudget, b.Limit, b.Currency)
+			}
+			if err := tr.validateBudget(); err != nil {
+				t.Errorf("reconciled budget does not validate: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateBudget(t *testing.T) {
+	// valid 검증을 통과하는 2일 내역 (2인)
+	valid := func() *TravelResponse {
+		tr := budgetResponse(2, &BudgetBreakdown{
+			Days: []DayBudget{
+				{Day: 1, BudgetItems: BudgetItems{Lodging: 80000, Food: 40000}, Total: 120000},
+				{Day: 2, BudgetItems: BudgetItems{Food: 30000, Transport: 20000}, Total: 50000},
+			},
+			PerPerson:      BudgetItems{Lodging: 80000, Food: 70000, Transport: 20000},
+			PerPersonTotal: 170000,
+			GroupSize:      2,
+			Total:          340000,
+		})
+		tr.EstimatedCost = 170000
+		return tr
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(tr *TravelResponse)
+		wantErr string // 오류 메시지에 포함될 문구 ("" 이면 통과)
+	}{
+		{name: "valid", mutate: func(tr *TravelResponse) {}},
+		{name: "no budget", mutate: func(tr *TravelResponse) { tr.Budget = nil }},
+		{name: "day count", mutate: func(tr *TravelResponse) { tr.Budget.Days = tr.Budget.Days[:1] }, wantErr: "must have 2 days"},
+		{name: "day order", mutate: func(tr *TravelResponse) { tr.Budget.Days[1].Day = 3 }, wantErr: "sequential"},
+		{name: "negative item", mutate: func(tr *TravelResponse) {
+			tr.Budget.Days[0].Food = -40000
+			tr.Budget.Days[0].Total = 40000
+		}, wantErr: "food must not be negative"},
+		{name: "day total", mutate: func(tr *TravelResponse) { tr.Budget.Days[0].Total = 1 }, wantErr: "day 1 total"},
+		{name: "per person items", mutate: func(tr *TravelResponse) { tr.Budget.PerPerson.Food = 1 }, wantErr: "per_person does not match"},
+		{name: "per person total", mutate: func(tr *TravelResponse) { tr.Budget.PerPersonTotal = 1 }, wantErr: "per_person_total 1"},
+		{name: "group total", mutate: func(tr *TravelResponse) { tr.Budget.Total = 170000 }, wantErr: "group_size"},
+		{name: "group size", mutate: func(tr *TravelResponse) {
+			tr.Budget.GroupSize = 0
+			tr.Budget.Total = 0
+		}, wantErr: "group_size"},
+		{name: "estimated cost", mutate: func(tr *TravelResponse) { tr.EstimatedCost = 1 }, wantErr: "estimated_cost 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := valid()
+			tt.mutate(tr)
+			err := tr.validateBudget()
+
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case tt.wantErr != "" && err == nil:
+				t.Errorf("expected error containing %q", tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Errorf("got error %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFormatThousands(t *testing.T) {
+	tests := map[int]string{
+		0:        "0",
+		999:      "999",
+		1000:     "1,000",
+		1250000:  "1,250,000",
+		-1250000: "-1,250,000",
+	}
+	for n, want := range tests {
+		if got := FormatThousands(n); got != want {
+			t.Errorf("FormatThousands(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
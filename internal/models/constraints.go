@@ -0,0 +1,205 @@
+package models
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// 여행자 제약 조건 태그 - 저장된 계획 필터와 생성 후 검사에 같은 값을 사용
+const (
+	ConstraintWheelchair     = "wheelchair"
+	ConstraintStroller       = "stroller"
+	ConstraintLimitedWalking = "limited_walking"
+	ConstraintVegetarian     = "vegetarian"
+	ConstraintVegan          = "vegan"
+	ConstraintHalal          = "halal"
+	ConstraintGlutenFree     = "gluten_free"
+	ConstraintKids           = "kids"
+	ConstraintPets           = "pets"
+)
+
+// AccessibilityOptions 지원하는 이동 약자 조건
+var AccessibilityOptions = map[string]bool{
+	ConstraintWheelchair:     true,
+	ConstraintStroller:       true,
+	ConstraintLimitedWalking: true,
+}
+
+// DietOptions 지원하는 식단 조건
+var DietOptions = map[string]bool{
+	ConstraintVegetarian: true,
+	ConstraintVegan:      true,
+	ConstraintHalal:      true,
+	ConstraintGlutenFree: true,
+}
+
+// ConstraintLabels 제약 조건의 한국어 표기
+var ConstraintLabels = map[string]string{
+	ConstraintWheelchair:     "휠체어 이용",
+	ConstraintStroller:       "유모차 동반",
+	ConstraintLimitedWalking: "오래 걷기 어려움",
+	ConstraintVegetarian:     "채식(육류·생선 제외)",
+	ConstraintVegan:          "비건(동물성 식품 제외)",
+	ConstraintHalal:          "할랄(돼지고기·술 제외)",
+	ConstraintGlutenFree:     "글루텐 프리",
+	ConstraintKids:           "어린이 동반",
+	ConstraintPets:           "반려동물 동반",
+}
+
+// TravelerConstraints 여행자 제약 조건 (접근성, 식단, 어린이/반려동물 동반)
+type TravelerConstraints struct {
+	Accessibility []string `json:"accessibility,omitempty" validate:"omitempty,dive,oneof=wheelchair stroller limited_walking" example:"wheelchair"`
+	Diet          []string `json:"diet,omitempty" validate:"omitempty,dive,oneof=vegetarian vegan halal gluten_free" example:"vegetarian"`
+	Kids          bool     `json:"kids,omitempty" example:"true"`
+	Pets          bool     `json:"pets,omitempty" example:"false"`
+}
+
+// Normalize 값을 소문자로 정리하고 중복을 제거한 뒤 허용된 값인지 검증
+func (tc *TravelerConstraints) Normalize() error {
+	if tc == nil {
+		return nil
+	}
+
+	var err error
+	if tc.Accessibility, err = normalizeOptions("accessibility", tc.Accessibility, AccessibilityOptions); err != nil {
+		return err
+	}
+	if tc.Diet, err = normalizeOptions("diet", tc.Diet, DietOptions); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Tags 저장과 검사에 쓰는 제약 조건 태그 (알파벳순, 조건이 없으면 nil)
+func (tc *TravelerConstraints) Tags() ConstraintTags {
+	if tc == nil {
+		return nil
+	}
+
+	var tags ConstraintTags
+	tags = append(tags, tc.Accessibility...)
+	tags = append(tags, tc.Diet...)
+	if tc.Kids {
+		tags = append(tags, ConstraintKids)
+	}
+	if tc.Pets {
+		tags = append(tags, ConstraintPets)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// normalizeOptions 목록 값을 정리하고 options에 없는 값이면 오류
+func normalizeOptions(field string, values []string, options map[string]bool) ([]string, error) {
+	seen := make(map[string]bool, len(values))
+	var normalized []string
+	for _, value := range values {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" || seen[value] {
+			continue
+		}
+		if !options[value] {
+			return nil, fmt.Errorf("unsupported %s constraint: %s", field, value)
+		}
+		seen[value] = true
+		normalized = append(normalized, value)
+	}
+	return normalized, nil
+}
+
+// ConstraintTags 제약 조건 태그 목록 - 데이터베이스에는 쉼표로 이은 문자열로 저장
+type ConstraintTags []string
+
+// ParseConstraintTags 쉼표로 구분한 태그 문자열 검증 (예: "wheelchair,halal")
+func ParseConstraintTags(text string) (ConstraintTags, error) {
+	var tags ConstraintTags
+	for _, tag := range strings.Split(text, ",") {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		if _, ok := ConstraintLabels[tag]; !ok {
+			return nil, fmt.Errorf("unsupported constraint: %s", tag)
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+// Has 태그 포함 여부
+func (ct ConstraintTags) Has(tag string) bool {
+	for _, t := range ct {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// GormDataType 문자열 컬럼으로 저장
+func (ConstraintTags) GormDataType() string {
+	return "string"
+}
+
+// Value driver.Valuer 구현
+func (ct ConstraintTags) Value() (driver.Value, error) {
+	return strings.Join(ct, ","), nil
+}
+
+// Scan sql.Scanner 구현
+func (ct *ConstraintTags) Scan(value interface{}) error {
+	var text string
+	switch v := value.(type) {
+	case nil:
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into ConstraintTags", value)
+	}
+
+	*ct = nil
+	for _, tag := range strings.Split(text, ",") {
+		if tag != "" {
+			*ct = append(*ct, tag)
+		}
+	}
+	return nil
+}
+
+// constraintsPrompt 여행자 제약 조건 안내 문구 (조건이 없으면 "")
+func constraintsPrompt(tags ConstraintTags) string {
+	if len(tags) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("여행자 조건은 다음과 같으며, 모든 활동과 식사는 이 조건을 반드시 지켜야 합니다:\n")
+	for _, tag := range tags {
+		fmt.Fprintf(&b, "- %s\n", ConstraintLabels[tag])
+	}
+
+	if tags.Has(ConstraintWheelchair) || tags.Has(ConstraintStroller) {
+		b.WriteString("계단, 등산, 비포장 산책로처럼 바퀴로 이동하기 어려운 장소는 피하고, 엘리베이터나 경사로가 있는 시설을 추천해주세요.\n")
+	}
+	if tags.Has(ConstraintLimitedWalking) {
+		b.WriteString("오래 걷는 도보 투어나 등산은 피하고 이동은 차량이나 대중교통 위주로 구성해주세요.\n")
+	}
+	if tags.Has(ConstraintKids) {
+		b.WriteString("술집, 클럽, 카지노 등 어린이가 들어갈 수 없는 장소는 제외해주세요.\n")
+	}
+	if tags.Has(ConstraintPets) {
+		b.WriteString("반려동물 동반이 가능한 장소와 숙소를 추천하고, 박물관이나 수족관처럼 동반이 어려운 실내 시설은 피해주세요.\n")
+	}
+	for _, tag := range tags {
+		if DietOptions[tag] {
+			b.WriteString("식사를 추천할 때는 조건에 맞는 메뉴가 있는 식당을 detail에 구체적으로 적어주세요.")
+			break
+		}
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
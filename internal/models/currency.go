@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ExchangeRateSnapshot 모델 - 관리자가 올린 환율표 (날짜별 1개)
+type ExchangeRateSnapshot struct {
+	ID         uint      `gorm:"primaryKey" json:"id"`
+	RatesDate  time.Time `gorm:"type:date;not null;uniqueIndex" json:"rates_date"` // 환율 기준일
+	Rates      string    `gorm:"type:text;not null" json:"rates"`                  // JSON {"USD": 1380.5} - 외화 1단위당 원화
+	Source     string    `gorm:"size:255" json:"source"`
+	UploadedBy *uint     `gorm:"index" json:"uploaded_by"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func (ExchangeRateSnapshot) TableName() string {
+	return "exchange_rate_snapshots"
+}
+
+// SaveRateSnapshot 환율표 저장 (같은 기준일이 있으면 덮어씀)
+func SaveRateSnapshot(db *gorm.DB, snapshot *ExchangeRateSnapshot) error {
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "rates_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rates", "source", "uploaded_by", "updated_at"}),
+	}).Create(snapshot).Error
+}
+
+// LatestRateSnapshot 기준일이 가장 최근인 환율표 (없으면 gorm.ErrRecordNotFound)
+func LatestRateSnapshot(db *gorm.DB) (*ExchangeRateSnapshot, error) {
+	var snapshot ExchangeRateSnapshot
+	if err := db.Order("rates_date DESC").First(&snapshot).Error; err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
@@ -0,0 +1,87 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"tripwand-backend/internal/calendar"
+)
+
+// TripStart 요청의 시작일 (날짜를 지정하지 않았으면 nil)
+// 종료일은 시작일과 함께 지정해야 하며, 시작일부터 종료일까지의 일수가 Duration과 같아야 합니다
+func (tr *TravelRequest) TripStart() (*time.Time, error) {
+	startText := strings.TrimSpace(getStringValue(tr.StartDate, ""))
+	endText := strings.TrimSpace(getStringValue(tr.EndDate, ""))
+
+	if startText == "" {
+		if endText != "" {
+			return nil, fmt.Errorf("start_date is required when end_date is set")
+		}
+		return nil, nil
+	}
+
+	start, err := time.Parse(calendar.DateLayout, startText)
+	if err != nil {
+		return nil, fmt.Errorf("start_date must be in YYYY-MM-DD format")
+	}
+
+	if endText != "" {
+		end, err := time.Parse(calendar.DateLayout, endText)
+		if err != nil {
+			return nil, fmt.Errorf("end_date must be in YYYY-MM-DD format")
+		}
+		if end.Before(start) {
+			return nil, fmt.Errorf("end_date must not be before start_date")
+		}
+		if days := int(end.Sub(start).Hours()/24) + 1; days != tr.Duration {
+			return nil, fmt.Errorf("dates span %d days but duration is %d", days, tr.Duration)
+		}
+	}
+
+	return &start, nil
+}
+
+// AnnotateDates 각 일차에 날짜, 요일, 공휴일 이름 기록
+func (tr *TravelResponse) AnnotateDates(start time.Time) {
+	days := calendar.Korea().Days(start, len(tr.Itinerary))
+	for i, day := range days {
+		tr.Itinerary[i].Date = day.Date.Format(calendar.DateLayout)
+		tr.Itinerary[i].Weekday = day.Date.Weekday().String()
+		tr.Itinerary[i].Holiday = day.Holiday
+	}
+}
+
+// datePrompt 일차별 날짜와 요일, 주말/공휴일 안내 문구
+func datePrompt(days []calendar.Day) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "여행 날짜는 %s부터 %s까지이며, 일차별 날짜는 다음과 같습니다:\n",
+		days[0].Date.Format(calendar.DateLayout), days[len(days)-1].Date.Format(calendar.DateLayout))
+
+	hasMonday, hasDayOff := false, false
+	for i, day := range days {
+		fmt.Fprintf(&b, "- %d일차: %s %s", i+1, day.Date.Format(calendar.DateLayout), day.WeekdayLabel())
+		switch {
+		case day.Holiday != "":
+			fmt.Fprintf(&b, " (공휴일: %s)", day.Holiday)
+		case day.Weekend:
+			b.WriteString(" (주말)")
+		}
+		b.WriteString("\n")
+
+		hasMonday = hasMonday || day.Date.Weekday() == time.Monday
+		hasDayOff = hasDayOff || day.DayOff()
+	}
+
+	if hasDayOff {
+		b.WriteString("\n주말과 공휴일에는 인기 관광지와 식당이 붐비고 교통이 혼잡하므로 예약이나 대안을 고려해주세요.")
+	}
+	if hasMonday {
+		b.WriteString("\n월요일에는 박물관, 미술관 등 정기 휴관하는 시설이 많으니 해당 날짜에는 휴관 여부를 고려해 일정을 짜주세요.")
+	}
+	if cal := calendar.Korea(); !cal.Covers(days[0].Date) || !cal.Covers(days[len(days)-1].Date) {
+		b.WriteString("\n일부 날짜는 공휴일 정보가 없으니 현지 공휴일을 확인하라는 주의사항을 포함해주세요.")
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"testing"
+
+	"tripwand-backend/internal/calendar"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestTripStart(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    *string
+		end      *string
+		duration int
+		want     string // 기대하는 시작일 ("" 이면 nil)
+		wantErr  bool
+	}{
+		{name: "no dates", duration: 3},
+		{name: "start only", start: strPtr("2026-09-24"), duration: 3, want: "2026-09-24"},
+		{name: "start and matching end", start: strPtr("2026-09-24"), end: strPtr("2026-09-26"), duration: 3, want: "2026-09-24"},
+		{name: "single day trip", start: strPtr("2026-10-03"), end: strPtr("2026-10-03"), duration: 1, want: "2026-10-03"},
+		{name: "across month end", start: strPtr("2026-12-30"), end: strPtr("2027-01-02"), duration: 4, want: "2026-12-30"},
+		{name: "surrounding spaces", start: strPtr(" 2026-09-24 "), duration: 2, want: "2026-09-24"},
+		{name: "blank start is no dates", start: strPtr(" "), duration: 2},
+		{name: "end without start", end: strPtr("2026-09-26"), duration: 3, wantErr: true},
+		{name: "invalid start", start: strPtr("2026/09/24"), duration: 3, wantErr: true},
+		{name: "invalid end", start: strPtr("2026-09-24"), end: strPtr("09-26"), duration: 3, wantErr: true},
+		{name: "end before start", start: strPtr("2026-09-24"), end: strPtr("2026-09-23"), duration: 3, wantErr: true},
+		{name: "span differs from duration", start: strPtr("2026-09-24"), end: strPtr("2026-09-27"), duration: 3, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &TravelRequest{StartDate: tt.start, EndDate: tt.end, Duration: tt.duration}
+			start, err := req.TripStart()
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got start %v", start)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := ""
+			if start != nil {
+				got = start.Format(calendar.DateLayout)
+			}
+			if got != tt.want {
+				t.Errorf("got start %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PlanLike 모델 - 사용자의 여행 계획 좋아요
+type PlanLike struct {
+	UserID    uint      `gorm:"primaryKey;autoIncrement:false" json:"user_id"`
+	PlanID    uint      `gorm:"primaryKey;autoIncrement:false;index" json:"plan_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (PlanLike) TableName() string {
+	return "plan_likes"
+}
+
+// PlanCollection 모델 - 북마크를 묶는 사용자 컬렉션 (예: "가을 여행 후보")
+type PlanCollection struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	UserID      uint      `gorm:"not null;uniqueIndex:idx_user_collection_name" json:"user_id"`
+	Name        string    `gorm:"size:100;not null;uniqueIndex:idx_user_collection_name" json:"name"`
+	Description string    `gorm:"size:500" json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+
+	// 응답용 집계 (컬럼 아님)
+	BookmarkCount int64 `gorm:"->;-:migration" json:"bookmark_count"`
+}
+
+func (PlanCollection) TableName() string {
+	return "plan_collections"
+}
+
+// PlanBookmark 모델 - 사용자의 여행 계획 북마크 (컬렉션은 선택)
+type PlanBookmark struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	UserID       uint      `gorm:"not null;uniqueIndex:idx_user_bookmark_plan" json:"user_id"`
+	PlanID       uint      `gorm:"not null;uniqueIndex:idx_user_bookmark_plan;index" json:"plan_id"`
+	CollectionID *uint     `gorm:"index" json:"collection_id"`
+	CreatedAt    time.Time `json:"created_at"`
+
+	// Relations
+	Plan *TravelPlans `gorm:"foreignKey:PlanID" json:"plan,omitempty"`
+}
+
+func (PlanBookmark) TableName() string {
+	return "plan_bookmarks"
+}
+
+// LikePlan 좋아요 추가 (이미 눌렀으면 변화 없음) - 실제로 추가됐는지 반환
+func LikePlan(db *gorm.DB, userID, planID uint) (bool, error) {
+	var added bool
+	err := db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&PlanLike{UserID: userID, PlanID: planID})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		added = true
+		return tx.Model(&TravelPlans{}).
+			Where("id = ?", planID).
+			UpdateColumn("like_count", gorm.Expr("like_count + 1")).Error
+	})
+	return added, err
+}
+
+// UnlikePlan 좋아요 취소 (누르지 않았으면 변화 없음) - 실제로 취소됐는지 반환
+func UnlikePlan(db *gorm.DB, userID, planID uint) (bool, error) {
+	var removed bool
+	err := db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("user_id = ? AND plan_id = ?", userID, planID).Delete(&PlanLike{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		removed = true
+		return tx.Model(&TravelPlans{}).
+			Where("id = ? AND like_count > 0", planID).
+			UpdateColumn("like_count", gorm.Expr("like_count - 1")).Error
+	})
+	return removed, err
+}
+
+// BookmarkPlan 북마크 추가 또는 컬렉션 변경 (같은 계획은 사용자당 하나)
+func BookmarkPlan(db *gorm.DB, userID, planID uint, collectionID *uint) (*PlanBookmark, error) {
+	bookmark := PlanBookmark{
+		UserID:       userID,
+		PlanID:       planID,
+		CollectionID: collectionID,
+	}
+	err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "plan_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"collection_id"}),
+	}).Create(&bookmark).Error
+	if err != nil {
+		return nil, err
+	}
+	return &bookmark, nil
+}
+
+// DeleteCollection 컬렉션 삭제 (안의 북마크는 유지하고 컬렉션만 해제)
+func DeleteCollection(db *gorm.DB, collection *PlanCollection) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&PlanBookmark{}).
+			Where("collection_id = ?", collection.ID).
+			Update("collection_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Delete(collection).Error
+	})
+}
+
+// VisiblePlansFor 사용자에게 보이는 계획 조건 (검수를 통과한 공개 계획 또는 본인 계획)
+func VisiblePlansFor(db *gorm.DB, userID uint) *gorm.DB {
+	return db.Where("((travel_plans.is_public = ? AND travel_plans.moderation_status = ?) OR travel_plans.user_id = ?)",
+		true, ModerationApproved, userID)
+}
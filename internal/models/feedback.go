@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PlanComment 모델 - 공개 여행 계획의 댓글 (답글은 한 단계까지)
+type PlanComment struct {
+	ID               uint           `gorm:"primaryKey" json:"id"`
+	PlanID           uint           `gorm:"not null;index" json:"plan_id"`
+	UserID           uint           `gorm:"not null;index" json:"user_id"`
+	ParentID         *uint          `gorm:"index" json:"parent_id"` // nullable - 최상위 댓글
+	Body             string         `gorm:"type:text;not null" json:"body"`
+	ModerationStatus string         `gorm:"size:20;default:approved;index" json:"moderation_status"` // approved만 다른 사용자에게 노출
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	DeletedAt        gorm.DeletedAt `gorm:"index" json:"-"`
+
+	// 응답용 (컬럼 아님)
+	IsDeleted bool          `gorm:"-" json:"is_deleted"`
+	Replies   []PlanComment `gorm:"-" json:"replies,omitempty"`
+
+	// Relations
+	Author *PublicProfile `gorm:"foreignKey:UserID" json:"author,omitempty"`
+}
+
+func (PlanComment) TableName() string {
+	return "plan_comments"
+}
+
+// PlanReview 모델 - "실제로 다녀왔어요" 별점 리뷰 (사용자당 계획별 1개)
+type PlanReview struct {
+	ID               uint           `gorm:"primaryKey" json:"id"`
+	PlanID           uint           `gorm:"not null;uniqueIndex:idx_plan_review_user,where:deleted_at IS NULL" json:"plan_id"`
+	UserID           uint           `gorm:"not null;uniqueIndex:idx_plan_review_user,where:deleted_at IS NULL" json:"user_id"`
+	Rating           int            `gorm:"not null" json:"rating" example:"5"`
+	Body             string         `gorm:"type:text" json:"body"`
+	TraveledAt       *time.Time     `json:"traveled_at"`                                             // 실제 여행한 날짜 (선택)
+	ModerationStatus string         `gorm:"size:20;default:approved;index" json:"moderation_status"` // approved만 다른 사용자에게 노출되고 별점에 반영
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	DeletedAt        gorm.DeletedAt `gorm:"index" json:"-"`
+
+	// Relations
+	Author *PublicProfile `gorm:"foreignKey:UserID" json:"author,omitempty"`
+}
+
+func (PlanReview) TableName() string {
+	return "plan_reviews"
+}
+
+// MaskDeleted 삭제된 댓글은 답글 흐름 유지를 위해 자리만 남기고 내용 제거
+func (pc *PlanComment) MaskDeleted() {
+	if pc.DeletedAt.Valid {
+		pc.IsDeleted = true
+		pc.Body = ""
+		pc.Author = nil
+	}
+}
+
+// RefreshPlanRating 삭제되지 않고 노출 중인 리뷰 기준으로 계획의 평균 별점/리뷰 수 재계산
+func RefreshPlanRating(tx *gorm.DB, planID uint) error {
+	return tx.Exec(`UPDATE travel_plans SET
+	rating_count = (SELECT COUNT(*) FROM plan_reviews r WHERE r.plan_id = ? AND r.deleted_at IS NULL AND r.moderation_status = ?),
+	rating_avg = COALESCE((SELECT ROUND(AVG(r.rating)::numeric, 2) FROM plan_reviews r WHERE r.plan_id = ? AND r.deleted_at IS NULL AND r.moderation_status = ?), 0)
+WHERE id = ?`, planID, ModerationApproved, planID, ModerationApproved, planID).Error
+}
+
+// RefreshPlanCommentCount 삭제되지 않고 노출 중인 댓글 수로 계획의 댓글 수 재계산
+func RefreshPlanCommentCount(tx *gorm.DB, planID uint) error {
+	return tx.Exec(`UPDATE travel_plans SET
+	comment_count = (SELECT COUNT(*) FROM plan_comments c WHERE c.plan_id = ? AND c.deleted_at IS NULL AND c.moderation_status = ?)
+WHERE id = ?`, planID, ModerationApproved, planID).Error
+}
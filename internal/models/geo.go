@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// GeoPlace 모델 - GeoNames 형식 덤프에서 가져온 지명 사전 항목
+type GeoPlace struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	GeonameID   int64     `gorm:"not null;uniqueIndex" json:"geoname_id"`
+	Name        string    `gorm:"size:200;not null" json:"name"`    // 대표 이름 (보통 로마자 표기)
+	NameKo      string    `gorm:"size:200" json:"name_ko"`          // 한국어 이름 (있을 때만)
+	AltNames    string    `gorm:"type:text" json:"alt_names"`       // 다른 표기 (| 구분, 한글/영문만)
+	Region      string    `gorm:"size:100;index" json:"region"`     // 1차 행정구역 이름 (예: Busan, Jeju-do)
+	CountryCode string    `gorm:"size:2;index" json:"country_code"` // ISO 3166-1 alpha-2
+	FeatureCode string    `gorm:"size:10" json:"feature_code"`      // GeoNames 분류 코드 (예: PPL, BCH)
+	Lat         float64   `gorm:"not null" json:"lat"`
+	Lng         float64   `gorm:"not null" json:"lng"`
+	Population  int64     `gorm:"default:0" json:"population"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func (GeoPlace) TableName() string {
+	return "geo_places"
+}
@@ -0,0 +1,188 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"tripwand-backend/internal/climate"
+)
+
+// MaxTripLegs 한 여행에서 방문할 수 있는 최대 도시 수
+const MaxTripLegs = 5
+
+// legSeparator 여러 도시 여행의 목적지 표기 구분자 (예: "서울 → 부산")
+const legSeparator = " → "
+
+// TripLeg 여러 도시 여행의 구간 요청 (방문 순서대로)
+type TripLeg struct {
+	Destination string `json:"destination" example:"부산"`
+	Nights      int    `json:"nights" example:"2"`
+}
+
+// PlanLeg 모델 - 여러 도시 여행 계획의 구간 (구간별 목적지 검색용)
+type PlanLeg struct {
+	ID          uint   `gorm:"primaryKey" json:"-"`
+	PlanID      uint   `gorm:"not null;uniqueIndex:idx_plan_leg_position" json:"-"`
+	Position    int    `gorm:"not null;uniqueIndex:idx_plan_leg_position" json:"position"` // 방문 순서 (1부터)
+	Destination string `gorm:"size:255;not null;index" json:"destination"`
+	Nights      int    `gorm:"not null" json:"nights"`
+	StartDay    int    `gorm:"not null" json:"start_day"` // 이 도시에서 시작하는 일차 (이동일)
+}
+
+func (PlanLeg) TableName() string {
+	return "plan_legs"
+}
+
+// NormalizeLegs 구간 요청을 검증하고 Destination과 Duration을 구간 기준으로 채움
+// 여행 일수는 전체 박 수 + 1이며, Duration을 함께 보냈다면 같아야 합니다
+func (tr *TravelRequest) NormalizeLegs() error {
+	if len(tr.Legs) == 0 {
+		return nil
+	}
+	if len(tr.Legs) > MaxTripLegs {
+		return fmt.Errorf("legs must not exceed %d", MaxTripLegs)
+	}
+
+	nights := 0
+	for i := range tr.Legs {
+		leg := &tr.Legs[i]
+		leg.Destination = strings.TrimSpace(leg.Destination)
+		if leg.Destination == "" {
+			return fmt.Errorf("leg %d destination is required", i+1)
+		}
+		if leg.Nights < 1 {
+			return fmt.Errorf("leg %d must have at least one night", i+1)
+		}
+		nights += leg.Nights
+	}
+
+	duration := nights + 1
+	if tr.Duration != 0 && tr.Duration != duration {
+		return fmt.Errorf("legs span %d days but duration is %d", duration, tr.Duration)
+	}
+
+	tr.Duration = duration
+	tr.Destination = JoinLegDestinations(tr.Legs)
+	return nil
+}
+
+// ClimateSummary 여행 기간의 평년 기후 (목적지를 알 수 없으면 nil)
+// 여러 도시 여행은 구간마다 그 도시에 머무는 날짜의 기후를 찾아 합칩니다 (이동일은 도착 도시 기준)
+func (tr *TravelRequest) ClimateSummary(start time.Time) *climate.Summary {
+	if len(tr.Legs) == 0 {
+		return climate.Lookup(tr.Destination, start, tr.Duration)
+	}
+
+	starts := legStartDays(tr.Legs)
+	stays := make([]climate.Stay, len(tr.Legs))
+	for i, leg := range tr.Legs {
+		end := tr.Duration + 1
+		if i+1 < len(starts) {
+			end = starts[i+1]
+		}
+		stays[i] = climate.Stay{
+			Destination: leg.Destination,
+			Start:       start.AddDate(0, 0, starts[i]-1),
+			Days:        end - starts[i],
+		}
+	}
+	return climate.LookupStays(stays)
+}
+
+// JoinLegDestinations 구간 목적지를 방문 순서대로 이은 표기
+func JoinLegDestinations(legs []TripLeg) string {
+	names := make([]string, len(legs))
+	for i, leg := range legs {
+		names[i] = leg.Destination
+	}
+	return strings.Join(names, legSeparator)
+}
+
+// legStartDays 구간별 시작 일차 (첫 구간은 1일차, 다음 구간은 앞 구간의 박 수만큼 뒤)
+func legStartDays(legs []TripLeg) []int {
+	starts := make([]int, len(legs))
+	day := 1
+	for i, leg := range legs {
+		starts[i] = day
+		day += leg.Nights
+	}
+	return starts
+}
+
+// legForDay 일차가 속한 구간 (이동일은 도착 도시의 구간)
+func legForDay(starts []int, day int) int {
+	index := 0
+	for i, start := range starts {
+		if day >= start {
+			index = i
+		}
+	}
+	return index
+}
+
+// NewPlanLegs 저장할 구간 목록
+func NewPlanLegs(legs []TripLeg) []PlanLeg {
+	starts := legStartDays(legs)
+	planLegs := make([]PlanLeg, len(legs))
+	for i, leg := range legs {
+		planLegs[i] = PlanLeg{
+			Position:    i + 1,
+			Destination: leg.Destination,
+			Nights:      leg.Nights,
+			StartDay:    starts[i],
+		}
+	}
+	return planLegs
+}
+
+// TripLegs 저장된 구간을 요청 형식으로 변환 (Legs를 불러온 계획만, 방문 순서대로)
+func (tp *TravelPlans) TripLegs() []TripLeg {
+	legs := make([]TripLeg, len(tp.Legs))
+	for i, leg := range tp.Legs {
+		legs[i] = TripLeg{Destination: leg.Destination, Nights: leg.Nights}
+	}
+	return legs
+}
+
+// AnnotateLegs 각 일차에 머무는 도시와 이동일 출발 도시 기록
+func (tr *TravelResponse) AnnotateLegs(legs []TripLeg) {
+	if len(legs) == 0 {
+		return
+	}
+	starts := legStartDays(legs)
+	for i := range tr.Itinerary {
+		day := &tr.Itinerary[i]
+		index := legForDay(starts, day.Day)
+		day.Destination = legs[index].Destination
+		day.TransferFrom = ""
+		if index > 0 && day.Day == starts[index] {
+			day.TransferFrom = legs[index-1].Destination
+		}
+	}
+}
+
+// legsPrompt 도시별 일정 범위와 이동일 안내 문구
+func legsPrompt(legs []TripLeg) string {
+	starts := legStartDays(legs)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "이번 여행은 %d개 도시를 순서대로 방문합니다:\n", len(legs))
+	for i, leg := range legs {
+		fmt.Fprintf(&b, "%d. %s %d박 (%d~%d일차)\n", i+1, leg.Destination, leg.Nights, starts[i], starts[i]+leg.Nights)
+	}
+
+	if len(legs) > 1 {
+		b.WriteString("\n도시 간 이동일:\n")
+		for i := 1; i < len(legs); i++ {
+			fmt.Fprintf(&b, "- %d일차: %s에서 %s(으)로 이동 (아침에 이동하고 도착 후 %s 일정을 시작)\n",
+				starts[i], legs[i-1].Destination, legs[i].Destination, legs[i].Destination)
+		}
+	}
+
+	last := legs[len(legs)-1]
+	fmt.Fprintf(&b, "\n각 일차의 활동은 그날 머무는 도시 안에서 구성하고, 이동일에는 이동 수단과 소요 시간을 detail에 포함해주세요. 마지막 날(%d일차)은 %s에서 여행을 마무리하는 일정입니다.",
+		starts[len(starts)-1]+last.Nights, last.Destination)
+
+	return b.String()
+}
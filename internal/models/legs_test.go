@@ -0,0 +1,58 @@
+package models
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestClimateSummaryPerLeg(t *testing.T) {
+	start := time.Date(2026, 6, 29, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		req        TravelRequest
+		wantMonths []string
+	}{
+		{
+			name:       "single destination",
+			req:        TravelRequest{Destination: "부산", Duration: 5},
+			wantMonths: []string{"-6", "-7"},
+		},
+		{
+			// 서울 6/29~6/30, 부산 7/1(이동일)~7/3
+			name: "each leg over its own dates",
+			req: TravelRequest{Duration: 5, Legs: []TripLeg{
+				{Destination: "서울", Nights: 2},
+				{Destination: "부산", Nights: 2},
+			}},
+			wantMonths: []string{"서울-6", "부산-7"},
+		},
+		{
+			// 서울 6/29~7/1, 제주 7/2~7/3
+			name: "leg crossing a month",
+			req: TravelRequest{Duration: 5, Legs: []TripLeg{
+				{Destination: "서울", Nights: 3},
+				{Destination: "제주", Nights: 1},
+			}},
+			wantMonths: []string{"서울-6", "서울-7", "제주-7"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := tt.req.ClimateSummary(start)
+			if summary == nil {
+				t.Fatal("got nil summary")
+			}
+			var months []string
+			for _, m := range summary.Months {
+				months = append(months, fmt.Sprintf("%s-%d", m.Region, m.Month))
+			}
+			if !reflect.DeepEqual(months, tt.wantMonths) {
+				t.Errorf("got months %v, want %v", months, tt.wantMonths)
+			}
+		})
+	}
+}
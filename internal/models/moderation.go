@@ -0,0 +1,309 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// ModerationTargetPlan 검수 대상 - 여행 계획
+	ModerationTargetPlan = "plan"
+	// ModerationTargetComment 검수 대상 - 댓글
+	ModerationTargetComment = "comment"
+	// ModerationTargetReview 검수 대상 - 리뷰
+	ModerationTargetReview = "review"
+
+	// ModerationApproved 다른 사용자에게 노출되는 콘텐츠
+	ModerationApproved = "approved"
+	// ModerationPending 검수 중이라 작성자에게만 보이는 콘텐츠
+	ModerationPending = "pending"
+	// ModerationHidden 관리자가 숨긴 콘텐츠 (작성자에게만 보임)
+	ModerationHidden = "hidden"
+
+	// ModerationItemOpen 관리자 검토를 기다리는 항목
+	ModerationItemOpen = "open"
+	// ModerationItemDeleted 관리자가 대상 콘텐츠를 삭제한 항목
+	ModerationItemDeleted = "deleted"
+
+	// ModerationSourceAuto 자동 검사로 등록된 항목
+	ModerationSourceAuto = "auto"
+	// ModerationSourceReport 사용자 신고로 등록된 항목
+	ModerationSourceReport = "report"
+
+	// ReportThreshold 신고가 이 횟수 이상 쌓이면 검토 전까지 콘텐츠를 숨김
+	ReportThreshold = 3
+)
+
+// ModerationTargets 검수 대상 종류
+var ModerationTargets = map[string]bool{
+	ModerationTargetPlan:    true,
+	ModerationTargetComment: true,
+	ModerationTargetReview:  true,
+}
+
+// ModerationItemStatuses 검토 큐 항목 상태 (open은 처리 대기, 나머지는 관리자 결정)
+var ModerationItemStatuses = map[string]bool{
+	ModerationItemOpen:    true,
+	ModerationApproved:    true,
+	ModerationHidden:      true,
+	ModerationItemDeleted: true,
+}
+
+// ReportReasons 신고 사유
+var ReportReasons = map[string]bool{
+	"spam":          true,
+	"abuse":         true,
+	"personal_info": true,
+	"inappropriate": true,
+	"other":         true,
+}
+
+// ModerationItem 모델 - 관리자 검토 큐 (대상 콘텐츠당 1개)
+type ModerationItem struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	TargetType  string     `gorm:"size:20;not null;uniqueIndex:idx_moderation_target" json:"target_type"`
+	TargetID    uint       `gorm:"not null;uniqueIndex:idx_moderation_target" json:"target_id"`
+	Status      string     `gorm:"size:20;not null;default:open;index" json:"status"`
+	Source      string     `gorm:"size:20;not null" json:"source"`
+	Findings    string     `gorm:"type:text" json:"findings"` // 자동 검사 결과 (JSON)
+	ReportCount int        `gorm:"default:0" json:"report_count"`
+	ReviewedBy  *uint      `json:"reviewed_by"`
+	ReviewedAt  *time.Time `json:"reviewed_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+func (ModerationItem) TableName() string {
+	return "moderation_items"
+}
+
+// ContentReport 모델 - 사용자 신고 (사용자당 대상별 1회)
+type ContentReport struct {
+	ID         uint      `gorm:"primaryKey" json:"id"`
+	ReporterID uint      `gorm:"not null;uniqueIndex:idx_report_reporter_target" json:"reporter_id"`
+	TargetType string    `gorm:"size:20;not null;uniqueIndex:idx_report_reporter_target;index:idx_report_target" json:"target_type"`
+	TargetID   uint      `gorm:"not null;uniqueIndex:idx_report_reporter_target;index:idx_report_target" json:"target_id"`
+	Reason     string    `gorm:"size:50;not null" json:"reason"`
+	Detail     string    `gorm:"size:1000" json:"detail"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (ContentReport) TableName() string {
+	return "content_reports"
+}
+
+// SetModerationStatus 대상 콘텐츠의 노출 상태 변경 (댓글/리뷰면 계획의 댓글 수/별점도 재계산)
+// 휴지통에 있거나 삭제된 콘텐츠도 복원 시 상태가 유지되도록 함께 변경합니다
+func SetModerationStatus(db *gorm.DB, targetType string, targetID uint, status string) error {
+	_, err := updateModerationStatus(db, targetType, targetID, status)
+	return err
+}
+
+// ApproveContent 자동 검사를 통과한 콘텐츠를 노출 - 실제로 승인됐는지 반환
+// 검수 중(pending)인 콘텐츠만 승인하므로 검사 도중 관리자가 내린 결정을 덮어쓰지 않고,
+// 신고가 ReportThreshold 이상 쌓여 관리자 검토를 기다리는 콘텐츠도 자동으로 다시 노출하지 않습니다
+func ApproveContent(db *gorm.DB, targetType string, targetID uint) (bool, error) {
+	return updateModerationStatus(db, targetType, targetID, ModerationApproved, func(q *gorm.DB) *gorm.DB {
+		return q.Where("moderation_status = ?", ModerationPending).
+			Where("NOT EXISTS (SELECT 1 FROM moderation_items WHERE target_type = ? AND target_id = ? AND status = ? AND report_count >= ?)",
+				targetType, targetID, ModerationItemOpen, ReportThreshold)
+	})
+}
+
+// updateModerationStatus 조건에 맞는 대상 콘텐츠의 노출 상태를 변경 - 변경됐는지 반환
+func updateModerationStatus(db *gorm.DB, targetType string, targetID uint, status string, conds ...func(*gorm.DB) *gorm.DB) (bool, error) {
+	var model interface{}
+	switch targetType {
+	case ModerationTargetPlan:
+		model = &TravelPlans{}
+	case ModerationTargetComment:
+		model = &PlanComment{}
+	case ModerationTargetReview:
+		model = &PlanReview{}
+	default:
+		return false, fmt.Errorf("unknown moderation target: %s", targetType)
+	}
+
+	var updated bool
+	err := db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Model(model).
+			Where("id = ?", targetID).
+			Scopes(conds...).
+			UpdateColumn("moderation_status", status)
+		if result.Error != nil {
+			return result.Error
+		}
+		updated = result.RowsAffected > 0
+		if !updated {
+			return nil
+		}
+
+		switch targetType {
+		case ModerationTargetComment:
+			var comment PlanComment
+			if err := tx.Unscoped().Select("plan_id").Where("id = ?", targetID).First(&comment).Error; err != nil {
+				return err
+			}
+			return RefreshPlanCommentCount(tx, comment.PlanID)
+		case ModerationTargetReview:
+			var review PlanReview
+			if err := tx.Unscoped().Select("plan_id").Where("id = ?", targetID).First(&review).Error; err != nil {
+				return err
+			}
+			return RefreshPlanRating(tx, review.PlanID)
+		}
+		return nil
+	})
+	return updated, err
+}
+
+// FlagContent 자동 검사에 걸린 콘텐츠를 숨기고 검토 큐에 등록
+// 관리자가 이미 숨기거나 삭제한 항목은 다시 열지 않습니다
+func FlagContent(db *gorm.DB, targetType string, targetID uint, findings string) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		item := ModerationItem{
+			TargetType: targetType,
+			TargetID:   targetID,
+			Status:     ModerationItemOpen,
+			Source:     ModerationSourceAuto,
+			Findings:   findings,
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "target_type"}, {Name: "target_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"status": gorm.Expr("CASE WHEN moderation_items.status IN (?, ?) THEN moderation_items.status ELSE ? END",
+					ModerationHidden, ModerationItemDeleted, ModerationItemOpen),
+				"findings":   findings,
+				"updated_at": time.Now(),
+			}),
+		}).Create(&item).Error; err != nil {
+			return err
+		}
+		// 검사 도중 관리자가 숨긴 콘텐츠는 숨김 상태 유지
+		_, err := updateModerationStatus(tx, targetType, targetID, ModerationPending, func(q *gorm.DB) *gorm.DB {
+			return q.Where("moderation_status <> ?", ModerationHidden)
+		})
+		return err
+	})
+}
+
+// ReportContent 신고 등록 (이미 신고했으면 변화 없음) - 실제로 등록됐는지 반환
+// 누적 신고가 ReportThreshold 이상이면 검토 전까지 콘텐츠를 숨깁니다
+func ReportContent(db *gorm.DB, report *ContentReport) (bool, error) {
+	var added bool
+	err := db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(report)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		added = true
+
+		// 관리자가 숨기거나 삭제한 항목은 다시 열지 않음
+		item := ModerationItem{
+			TargetType:  report.TargetType,
+			TargetID:    report.TargetID,
+			Status:      ModerationItemOpen,
+			Source:      ModerationSourceReport,
+			ReportCount: 1,
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "target_type"}, {Name: "target_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"report_count": gorm.Expr("moderation_items.report_count + 1"),
+				"status": gorm.Expr("CASE WHEN moderation_items.status IN (?, ?) THEN moderation_items.status ELSE ? END",
+					ModerationHidden, ModerationItemDeleted, ModerationItemOpen),
+				"updated_at": time.Now(),
+			}),
+		}).Create(&item).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("target_type = ? AND target_id = ?", report.TargetType, report.TargetID).
+			First(&item).Error; err != nil {
+			return err
+		}
+		if item.Status == ModerationItemOpen && item.ReportCount >= ReportThreshold {
+			return SetModerationStatus(tx, report.TargetType, report.TargetID, ModerationPending)
+		}
+		return nil
+	})
+	return added, err
+}
+
+// ResolveModerationItem 관리자 결정 반영 (approved: 노출, hidden: 숨김, deleted: 대상 삭제)
+func ResolveModerationItem(db *gorm.DB, item *ModerationItem, status string, reviewerID uint) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		switch status {
+		case ModerationApproved, ModerationHidden:
+			if err := SetModerationStatus(tx, item.TargetType, item.TargetID, status); err != nil {
+				return err
+			}
+		case ModerationItemDeleted:
+			if err := deleteModerationTarget(tx, item.TargetType, item.TargetID); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("invalid moderation decision: %s", status)
+		}
+
+		now := time.Now()
+		updates := map[string]interface{}{
+			"status":      status,
+			"reviewed_by": reviewerID,
+			"reviewed_at": now,
+		}
+		// 승인 후에는 새로 쌓인 신고만 다시 기준에 포함
+		if status == ModerationApproved {
+			updates["report_count"] = 0
+		}
+		return tx.Model(item).Updates(updates).Error
+	})
+}
+
+// deleteModerationTarget 검수 대상 콘텐츠 삭제 (soft delete, 계획은 휴지통으로 이동)
+func deleteModerationTarget(tx *gorm.DB, targetType string, targetID uint) error {
+	switch targetType {
+	case ModerationTargetPlan:
+		// 소유자가 휴지통에서 복원해도 다시 노출되지 않도록 숨김 상태 유지
+		if err := SetModerationStatus(tx, targetType, targetID, ModerationHidden); err != nil {
+			return err
+		}
+		return tx.Where("id = ?", targetID).Delete(&TravelPlans{}).Error
+	case ModerationTargetComment:
+		var comment PlanComment
+		if err := tx.Unscoped().Where("id = ?", targetID).First(&comment).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&comment).Error; err != nil {
+			return err
+		}
+		return RefreshPlanCommentCount(tx, comment.PlanID)
+	case ModerationTargetReview:
+		var review PlanReview
+		if err := tx.Unscoped().Where("id = ?", targetID).First(&review).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&review).Error; err != nil {
+			return err
+		}
+		return RefreshPlanRating(tx, review.PlanID)
+	}
+	return fmt.Errorf("unknown moderation target: %s", targetType)
+}
+
+// deleteModerationRecords 영구 삭제되는 콘텐츠의 검토 큐 항목과 신고 기록 삭제
+func deleteModerationRecords(tx *gorm.DB, targetType string, targetIDs []uint) error {
+	if len(targetIDs) == 0 {
+		return nil
+	}
+	if err := tx.Where("target_type = ? AND target_id IN ?", targetType, targetIDs).Delete(&ModerationItem{}).Error; err != nil {
+		return err
+	}
+	return tx.Where("target_type = ? AND target_id IN ?", targetType, targetIDs).Delete(&ContentReport{}).Error
+}
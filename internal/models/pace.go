@@ -0,0 +1,248 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// PaceRelaxed 하루 활동을 적게 (휴식 위주)
+	PaceRelaxed = "relaxed"
+	// PaceBalanced 활동과 휴식을 균형 있게
+	PaceBalanced = "balanced"
+	// PacePacked 하루 일정을 빼곡하게
+	PacePacked = "packed"
+)
+
+// FreeTimeSummary 활동이 없는 시간대의 요약 (하루 활동 수 제한에 맞춰 비워 둔 시간대)
+const FreeTimeSummary = "자유 시간"
+
+// restDayMaxActivities 휴식일에 허용하는 최대 활동 수
+const restDayMaxActivities = 1
+
+// PaceProfile 여행 속도별 기본값
+type PaceProfile struct {
+	Label         string
+	MaxActivities int // 하루 최대 활동 수 (시간대 4개 중)
+}
+
+// PaceProfiles 지원하는 여행 속도
+var PaceProfiles = map[string]PaceProfile{
+	PaceRelaxed:  {Label: "여유로운 여행", MaxActivities: 2},
+	PaceBalanced: {Label: "균형잡힌 여행", MaxActivities: 3},
+	PacePacked:   {Label: "빼곡한 여행", MaxActivities: 4},
+}
+
+// freeTimeKeywords 활동이 아닌 휴식 시간대로 보는 요약 키워드
+var freeTimeKeywords = []string{"자유 시간", "자유시간", "휴식", "free time"}
+
+// SchedulePreferences 검증을 마친 일정 선호 조건
+type SchedulePreferences struct {
+	Pace          string `json:"pace,omitempty"`
+	WakeUpTime    string `json:"wake_up_time,omitempty"`
+	DayEndTime    string `json:"day_end_time,omitempty"`
+	MaxActivities int    `json:"max_activities_per_day"`
+	RestDays      []int  `json:"rest_days,omitempty"`
+}
+
+// Schedule 속도와 하루 일정 선호를 검증해 정리 (아무것도 지정하지 않았으면 nil)
+// 하루 최대 활동 수는 직접 지정한 값이 속도 기본값보다 우선합니다. Duration이 확정된 뒤 호출해야 합니다
+// 속도는 소문자로 정리해 요청에 다시 저장하므로 이후 프롬프트도 정리된 값을 사용합니다
+func (tr *TravelRequest) Schedule() (*SchedulePreferences, error) {
+	pace := strings.ToLower(strings.TrimSpace(getStringValue(tr.Pace, "")))
+	if tr.Pace != nil {
+		tr.Pace = &pace
+	}
+	if pace == "" && tr.WakeUpTime == nil && tr.DayEndTime == nil && tr.MaxActivitiesPerDay == nil && len(tr.RestDays) == 0 {
+		return nil, nil
+	}
+
+	prefs := &SchedulePreferences{Pace: pace, MaxActivities: len(PeriodNames)}
+	if pace != "" {
+		profile, ok := PaceProfiles[pace]
+		if !ok {
+			return nil, fmt.Errorf("unsupported pace: %s", pace)
+		}
+		prefs.MaxActivities = profile.MaxActivities
+	}
+
+	if tr.MaxActivitiesPerDay != nil {
+		if *tr.MaxActivitiesPerDay < 1 || *tr.MaxActivitiesPerDay > len(PeriodNames) {
+			return nil, fmt.Errorf("max_activities_per_day must be between 1 and %d", len(PeriodNames))
+		}
+		prefs.MaxActivities = *tr.MaxActivitiesPerDay
+	}
+
+	var err error
+	if prefs.WakeUpTime, err = normalizeClock("wake_up_time", tr.WakeUpTime); err != nil {
+		return nil, err
+	}
+	if prefs.DayEndTime, err = normalizeClock("day_end_time", tr.DayEndTime); err != nil {
+		return nil, err
+	}
+	if prefs.WakeUpTime != "" && prefs.DayEndTime != "" && prefs.WakeUpTime >= prefs.DayEndTime {
+		return nil, fmt.Errorf("day_end_time must be after wake_up_time")
+	}
+
+	seen := make(map[int]bool, len(tr.RestDays))
+	for _, day := range tr.RestDays {
+		if day < 1 || day > tr.Duration {
+			return nil, fmt.Errorf("rest day %d is outside the %d-day trip", day, tr.Duration)
+		}
+		if !seen[day] {
+			seen[day] = true
+			prefs.RestDays = append(prefs.RestDays, day)
+		}
+	}
+	sort.Ints(prefs.RestDays)
+
+	return prefs, nil
+}
+
+// TravelTypeOrDefault 프롬프트에 쓰는 여행 스타일 (직접 적은 값 > 속도 표기 > 기본값)
+func (tr *TravelRequest) TravelTypeOrDefault() string {
+	if profile, ok := PaceProfiles[getStringValue(tr.Pace, "")]; ok {
+		return getStringValue(tr.TravelType, profile.Label)
+	}
+	return getStringValue(tr.TravelType, "균형잡힌 여행")
+}
+
+// IsRestDay 휴식일 여부
+func (sp *SchedulePreferences) IsRestDay(day int) bool {
+	for _, rest := range sp.RestDays {
+		if rest == day {
+			return true
+		}
+	}
+	return false
+}
+
+// maxActivities 해당 일차의 최대 활동 수 (휴식일은 1개)
+func (sp *SchedulePreferences) maxActivities(day int) int {
+	if sp.IsRestDay(day) && sp.MaxActivities > restDayMaxActivities {
+		return restDayMaxActivities
+	}
+	return sp.MaxActivities
+}
+
+// prompt 속도와 하루 일정 안내 문구
+func (sp *SchedulePreferences) prompt() string {
+	var b strings.Builder
+	if profile, ok := PaceProfiles[sp.Pace]; ok {
+		fmt.Fprintf(&b, "%s을 원합니다. ", profile.Label)
+	}
+	if sp.MaxActivities < len(PeriodNames) {
+		fmt.Fprintf(&b, "하루 활동은 최대 %d개로 하고, 나머지 시간대는 summary를 \"%s\"으로 적고 detail에 쉬는 방법을 적어주세요.", sp.MaxActivities, FreeTimeSummary)
+	} else {
+		b.WriteString("하루 네 시간대를 모두 활동으로 채워도 됩니다.")
+	}
+
+	switch {
+	case sp.WakeUpTime != "" && sp.DayEndTime != "":
+		fmt.Fprintf(&b, "\n하루 일정은 %s 이후에 시작해 %s 전에 끝나야 합니다.", sp.WakeUpTime, sp.DayEndTime)
+	case sp.WakeUpTime != "":
+		fmt.Fprintf(&b, "\n하루 일정은 %s 이후에 시작해야 합니다.", sp.WakeUpTime)
+	case sp.DayEndTime != "":
+		fmt.Fprintf(&b, "\n하루 일정은 %s 전에 끝나야 합니다.", sp.DayEndTime)
+	}
+	if sp.WakeUpTime != "" || sp.DayEndTime != "" {
+		b.WriteString(" 각 활동에 start_time과 end_time(HH:MM)을 넣고, 이 시간을 벗어나는 시간대는 자유 시간으로 비워주세요.")
+	}
+
+	if len(sp.RestDays) > 0 {
+		days := make([]string, len(sp.RestDays))
+		for i, day := range sp.RestDays {
+			days[i] = fmt.Sprintf("%d일차", day)
+		}
+		fmt.Fprintf(&b, "\n휴식일(%s)에는 가벼운 활동 %d개만 넣고 나머지는 자유 시간으로 비워주세요.", strings.Join(days, ", "), restDayMaxActivities)
+	}
+
+	return b.String()
+}
+
+// ScheduleIssue 일정 선호 조건에 맞지 않는 부분
+type ScheduleIssue struct {
+	Day     int    `json:"day"`
+	Period  string `json:"period,omitempty"`
+	Message string `json:"message"`
+}
+
+// ScheduleReport 생성된 일정의 속도/시간 선호 검사 결과
+type ScheduleReport struct {
+	SchedulePreferences
+	ActivitiesPerDay  []int           `json:"activities_per_day"` // 자유 시간을 뺀 일차별 활동 수
+	Issues            []ScheduleIssue `json:"issues"`
+	WithinPreferences bool            `json:"within_preferences"`
+}
+
+// CheckSchedule 일차별 활동 수와 활동 시각이 선호 조건 안에 있는지 검사
+func (tr *TravelResponse) CheckSchedule(prefs *SchedulePreferences) *ScheduleReport {
+	report := &ScheduleReport{SchedulePreferences: *prefs, Issues: []ScheduleIssue{}}
+	wakeUp, _ := ClockMinutes(prefs.WakeUpTime)
+	dayEnd, hasDayEnd := ClockMinutes(prefs.DayEndTime)
+
+	for _, day := range tr.Itinerary {
+		count := 0
+		for _, name := range PeriodNames {
+			period := day.Period(name)
+			if IsFreeTime(period) {
+				continue
+			}
+			count++
+
+			label := fmt.Sprintf("%d일차 %s '%s'", day.Day, PeriodLabels[name], period.Summary)
+			start, hasStart := ClockMinutes(period.StartTime)
+			end, hasEnd := ClockMinutes(period.EndTime)
+			switch {
+			case hasStart && start < wakeUp:
+				report.Issues = append(report.Issues, ScheduleIssue{Day: day.Day, Period: name,
+					Message: fmt.Sprintf("%s은(는) %s에 시작해 기상 시각 %s보다 이릅니다", label, period.StartTime, prefs.WakeUpTime)})
+			case hasDayEnd && hasStart && start >= dayEnd:
+				report.Issues = append(report.Issues, ScheduleIssue{Day: day.Day, Period: name,
+					Message: fmt.Sprintf("%s은(는) %s에 시작해 일정 종료 시각 %s보다 늦습니다", label, period.StartTime, prefs.DayEndTime)})
+			case hasDayEnd && hasEnd && (end > dayEnd || (hasStart && end < start)):
+				report.Issues = append(report.Issues, ScheduleIssue{Day: day.Day, Period: name,
+					Message: fmt.Sprintf("%s은(는) %s에 끝나 일정 종료 시각 %s를 넘깁니다", label, period.EndTime, prefs.DayEndTime)})
+			}
+		}
+
+		report.ActivitiesPerDay = append(report.ActivitiesPerDay, count)
+		if limit := prefs.maxActivities(day.Day); count > limit {
+			kind := "하루 최대"
+			if prefs.IsRestDay(day.Day) {
+				kind = "휴식일 최대"
+			}
+			report.Issues = append(report.Issues, ScheduleIssue{Day: day.Day,
+				Message: fmt.Sprintf("%d일차 활동이 %d개로 %s %d개를 넘습니다", day.Day, count, kind, limit)})
+		}
+	}
+
+	report.WithinPreferences = len(report.Issues) == 0
+	return report
+}
+
+// IsFreeTime 활동이 아닌 자유 시간/휴식 시간대인지 여부
+func IsFreeTime(period *ActivityPeriod) bool {
+	summary := strings.ToLower(period.Summary)
+	for _, keyword := range freeTimeKeywords {
+		if strings.Contains(summary, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeClock HH:MM 시각을 두 자리 형식으로 정리 (미지정이면 "")
+func normalizeClock(field string, value *string) (string, error) {
+	text := strings.TrimSpace(getStringValue(value, ""))
+	if text == "" {
+		return "", nil
+	}
+	t, err := time.Parse(ActivityTimeLayout, text)
+	if err != nil {
+		return "", fmt.Errorf("%s %q must be in HH:MM format", field, text)
+	}
+	return t.Format(ActivityTimeLayout), nil
+}
@@ -0,0 +1,189 @@
+package models
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func intPtr(n int) *int {
+	return &n
+}
+
+func TestSchedule(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     TravelRequest
+		want    *SchedulePreferences
+		wantErr bool
+	}{
+		{name: "nothing set", req: TravelRequest{Duration: 3}},
+		{name: "blank pace only", req: TravelRequest{Pace: strPtr(""), Duration: 3}},
+		{name: "relaxed default", req: TravelRequest{Pace: strPtr(PaceRelaxed), Duration: 3},
+			want: &SchedulePreferences{Pace: PaceRelaxed, MaxActivities: 2}},
+		{name: "packed default", req: TravelRequest{Pace: strPtr(PacePacked), Duration: 3},
+			want: &SchedulePreferences{Pace: PacePacked, MaxActivities: 4}},
+		{name: "pace case and spaces", req: TravelRequest{Pace: strPtr(" Packed "), Duration: 3},
+			want: &SchedulePreferences{Pace: PacePacked, MaxActivities: 4}},
+		{name: "unknown pace", req: TravelRequest{Pace: strPtr("slow"), Duration: 3}, wantErr: true},
+		{name: "max overrides pace", req: TravelRequest{Pace: strPtr(PacePacked), MaxActivitiesPerDay: intPtr(1), Duration: 3},
+			want: &SchedulePreferences{Pace: PacePacked, MaxActivities: 1}},
+		{name: "max without pace", req: TravelRequest{MaxActivitiesPerDay: intPtr(3), Duration: 3},
+			want: &SchedulePreferences{MaxActivities: 3}},
+		{name: "max below range", req: TravelRequest{MaxActivitiesPerDay: intPtr(0), Duration: 3}, wantErr: true},
+		{name: "max above range", req: TravelRequest{MaxActivitiesPerDay: intPtr(5), Duration: 3}, wantErr: true},
+		{name: "clock normalized", req: TravelRequest{WakeUpTime: strPtr(" 9:00 "), DayEndTime: strPtr("21:30"), Duration: 3},
+			want: &SchedulePreferences{WakeUpTime: "09:00", DayEndTime: "21:30", MaxActivities: 4}},
+		{name: "invalid wake up", req: TravelRequest{WakeUpTime: strPtr("9am"), Duration: 3}, wantErr: true},
+		{name: "invalid day end", req: TravelRequest{DayEndTime: strPtr("25:00"), Duration: 3}, wantErr: true},
+		{name: "day end before wake up", req: TravelRequest{WakeUpTime: strPtr("10:00"), DayEndTime: strPtr("09:00"), Duration: 3}, wantErr: true},
+		{name: "day end equals wake up", req: TravelRequest{WakeUpTime: strPtr("10:00"), DayEndTime: strPtr("10:00"), Duration: 3}, wantErr: true},
+		{name: "rest days deduplicated and sorted", req: TravelRequest{RestDays: []int{3, 1, 3}, Duration: 3},
+			want: &SchedulePreferences{MaxActivities: 4, RestDays: []int{1, 3}}},
+		{name: "rest day after trip", req: TravelRequest{RestDays: []int{4}, Duration: 3}, wantErr: true},
+		{name: "rest day zero", req: TravelRequest{RestDays: []int{0}, Duration: 3}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.req.Schedule()
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+			if got != nil && got.Pace != getStringValue(tt.req.Pace, "") {
+				t.Errorf("request pace %q was not normalized to %q", getStringValue(tt.req.Pace, ""), got.Pace)
+			}
+		})
+	}
+}
+
+func TestScheduleNormalizesPaceForPrompt(t *testing.T) {
+	req := &TravelRequest{Pace: strPtr(" Relaxed"), Duration: 2}
+	if _, err := req.Schedule(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := req.TravelTypeOrDefault(); got != PaceProfiles[PaceRelaxed].Label {
+		t.Errorf("got travel type %q, want %q", got, PaceProfiles[PaceRelaxed].Label)
+	}
+}
+
+func TestClockMinutes(t *testing.T) {
+	tests := []struct {
+		clock  string
+		want   int
+		wantOK bool
+	}{
+		{clock: "00:00", want: 0, wantOK: true},
+		{clock: "09:30", want: 570, wantOK: true},
+		{clock: "9:05", want: 545, wantOK: true},
+		{clock: "23:59", want: 1439, wantOK: true},
+		{clock: "", wantOK: false},
+		{clock: "24:00", wantOK: false},
+		{clock: "9am", wantOK: false},
+	}
+	for _, tt := range tests {
+		got, ok := ClockMinutes(tt.clock)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("ClockMinutes(%q) = %d, %v, want %d, %v", tt.clock, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestCheckSchedule(t *testing.T) {
+	free := ActivityPeriod{Summary: FreeTimeSummary, Detail: "숙소에서 휴식"}
+
+	tests := []struct {
+		name       string
+		prefs      SchedulePreferences
+		day        DayItinerary
+		wantCount  int
+		wantIssues []string // 기대하는 이슈 메시지의 일부 (순서대로)
+	}{
+		{
+			name:      "within preferences",
+			prefs:     SchedulePreferences{WakeUpTime: "09:00", DayEndTime: "21:00", MaxActivities: 3},
+			day:       DayItinerary{Day: 1, Morning: ActivityPeriod{Summary: "해운대 산책", StartTime: "09:00", EndTime: "11:00"}, Afternoon: ActivityPeriod{Summary: "자갈치시장"}, Evening: ActivityPeriod{Summary: "광안리 야경", StartTime: "19:00", EndTime: "21:00"}, Night: free},
+			wantCount: 3,
+		},
+		{
+			name:       "starts before wake up",
+			prefs:      SchedulePreferences{WakeUpTime: "09:00", MaxActivities: 4},
+			day:        DayItinerary{Day: 1, Morning: ActivityPeriod{Summary: "일출", StartTime: "06:00", EndTime: "07:00"}, Afternoon: free, Evening: free, Night: free},
+			wantCount:  1,
+			wantIssues: []string{"기상 시각 09:00보다 이릅니다"},
+		},
+		{
+			name:       "starts after day end",
+			prefs:      SchedulePreferences{DayEndTime: "21:00", MaxActivities: 4},
+			day:        DayItinerary{Day: 2, Morning: free, Afternoon: free, Evening: free, Night: ActivityPeriod{Summary: "포차 거리", StartTime: "22:00"}},
+			wantCount:  1,
+			wantIssues: []string{"일정 종료 시각 21:00보다 늦습니다"},
+		},
+		{
+			name:       "ends after day end",
+			prefs:      SchedulePreferences{DayEndTime: "21:00", MaxActivities: 4},
+			day:        DayItinerary{Day: 1, Morning: free, Afternoon: free, Evening: ActivityPeriod{Summary: "야경 투어", StartTime: "19:00", EndTime: "22:30"}, Night: free},
+			wantCount:  1,
+			wantIssues: []string{"22:30에 끝나 일정 종료 시각 21:00를 넘깁니다"},
+		},
+		{
+			name:       "ends past midnight",
+			prefs:      SchedulePreferences{DayEndTime: "23:00", MaxActivities: 4},
+			day:        DayItinerary{Day: 1, Morning: free, Afternoon: free, Evening: free, Night: ActivityPeriod{Summary: "클럽", StartTime: "22:00", EndTime: "01:00"}},
+			wantCount:  1,
+			wantIssues: []string{"01:00에 끝나"},
+		},
+		{
+			name:       "too many activities",
+			prefs:      SchedulePreferences{Pace: PaceRelaxed, MaxActivities: 2},
+			day:        DayItinerary{Day: 2, Morning: ActivityPeriod{Summary: "감천문화마을"}, Afternoon: ActivityPeriod{Summary: "태종대"}, Evening: ActivityPeriod{Summary: "광안리"}, Night: free},
+			wantCount:  3,
+			wantIssues: []string{"2일차 활동이 3개로 하루 최대 2개를 넘습니다"},
+		},
+		{
+			name:       "rest day limit",
+			prefs:      SchedulePreferences{MaxActivities: 3, RestDays: []int{1}},
+			day:        DayItinerary{Day: 1, Morning: ActivityPeriod{Summary: "브런치"}, Afternoon: ActivityPeriod{Summary: "카페 투어"}, Evening: free, Night: free},
+			wantCount:  2,
+			wantIssues: []string{"1일차 활동이 2개로 휴식일 최대 1개를 넘습니다"},
+		},
+		{
+			name:      "free time is not checked",
+			prefs:     SchedulePreferences{WakeUpTime: "10:00", DayEndTime: "20:00", MaxActivities: 1},
+			day:       DayItinerary{Day: 1, Morning: ActivityPeriod{Summary: "휴식", StartTime: "07:00"}, Afternoon: ActivityPeriod{Summary: "해운대 산책"}, Evening: ActivityPeriod{Summary: "Free time", EndTime: "23:00"}, Night: free},
+			wantCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &TravelResponse{Itinerary: []DayItinerary{tt.day}}
+			report := data.CheckSchedule(&tt.prefs)
+
+			if !reflect.DeepEqual(report.ActivitiesPerDay, []int{tt.wantCount}) {
+				t.Errorf("got activities %v, want [%d]", report.ActivitiesPerDay, tt.wantCount)
+			}
+			if len(report.Issues) != len(tt.wantIssues) {
+				t.Fatalf("got issues %+v, want %d", report.Issues, len(tt.wantIssues))
+			}
+			for i, want := range tt.wantIssues {
+				if issue := report.Issues[i]; issue.Day != tt.day.Day || !strings.Contains(issue.Message, want) {
+					t.Errorf("issue %d: got %+v, want day %d message containing %q", i, issue, tt.day.Day, want)
+				}
+			}
+			if report.WithinPreferences != (len(tt.wantIssues) == 0) {
+				t.Errorf("got within_preferences %v with issues %+v", report.WithinPreferences, report.Issues)
+			}
+		})
+	}
+}
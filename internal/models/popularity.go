@@ -0,0 +1,146 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// TrendingHalfLifeDays 조회수 가중치가 절반으로 줄어드는 기간 (일)
+	TrendingHalfLifeDays = 3.0
+	// RecencyHalfLifeDays 신규 계획 가산점이 절반으로 줄어드는 기간 (일)
+	RecencyHalfLifeDays = 7.0
+	// ForkWeight 인기순 정렬에서 복사 1회가 갖는 조회수 환산 가중치
+	ForkWeight = 10
+	// LikeWeight 인기순 정렬에서 좋아요 1회가 갖는 조회수 환산 가중치
+	LikeWeight = 5
+)
+
+// TrendingWindows 트렌딩 집계 기간 (일 단위 UTC 기록이므로 오늘을 포함한 최근 N일, 1d는 24h의 별칭)
+var TrendingWindows = map[string]int{
+	"24h": 1,
+	"1d":  1,
+	"7d":  7,
+	"30d": 30,
+}
+
+// trendingScoreSQL 조회 기록 한 행(v)의 트렌딩 점수 - RefreshTrendingScores와 트렌딩 조회가 함께 사용
+// 일별 조회수 × 0.5^(경과일/3) × (1 + 0.5^(계획 나이/7))
+var trendingScoreSQL = fmt.Sprintf(`v.views * power(0.5, ((NOW() AT TIME ZONE 'UTC')::date - v.day) / %.1f)
+	* (1 + power(0.5, EXTRACT(EPOCH FROM (NOW() - p.created_at)) / 86400.0 / %.1f))`,
+	TrendingHalfLifeDays, RecencyHalfLifeDays)
+
+// windowStartSQL 집계 기간의 첫날 (오늘 포함 N일이므로 오늘 - (N-1))
+const windowStartSQL = "(NOW() AT TIME ZONE 'UTC')::date - (?::int - 1)"
+
+// PlanSortOrders 여행 계획 목록 정렬 기준
+var PlanSortOrders = map[string]string{
+	"recent":   "created_at DESC",
+	"popular":  fmt.Sprintf("(view_count + fork_count * %d + like_count * %d) DESC, created_at DESC", ForkWeight, LikeWeight),
+	"trending": "trending_score DESC, created_at DESC",
+	"rating":   "rating_avg DESC, rating_count DESC, created_at DESC",
+}
+
+// PlanDailyView 모델 - 여행 계획의 일별 조회수 기록
+type PlanDailyView struct {
+	PlanID uint      `gorm:"primaryKey;autoIncrement:false" json:"plan_id"`
+	Day    time.Time `gorm:"primaryKey;type:date" json:"day"`
+	Views  int64     `gorm:"not null;default:0" json:"views"`
+}
+
+func (PlanDailyView) TableName() string {
+	return "plan_daily_views"
+}
+
+// TrendingPlan 트렌딩 계획 항목
+type TrendingPlan struct {
+	ID          uint    `json:"id"`
+	Title       string  `json:"title"`
+	Destination string  `json:"destination"`
+	Duration    int     `json:"duration"`
+	Views       int64   `json:"views"`
+	Score       float64 `json:"score"`
+}
+
+// TrendingDestination 트렌딩 목적지 항목
+type TrendingDestination struct {
+	Destination string  `json:"destination"`
+	Plans       int64   `json:"plans"`
+	Views       int64   `json:"views"`
+	Score       float64 `json:"score"`
+}
+
+// RecordPlanViews 오늘(UTC) 날짜의 조회 기록에 views만큼 더함
+func RecordPlanViews(db *gorm.DB, planID uint, views int64) error {
+	return db.Exec(`INSERT INTO plan_daily_views (plan_id, day, views)
+VALUES (?, (NOW() AT TIME ZONE 'UTC')::date, ?)
+ON CONFLICT (plan_id, day) DO UPDATE SET views = plan_daily_views.views + EXCLUDED.views`,
+		planID, views).Error
+}
+
+// IncrementPlanViews 계획의 누적 조회수와 일별 조회 기록을 원자적으로 증가
+func IncrementPlanViews(db *gorm.DB, planID uint, views int64) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		// updated_at은 내용 변경 시각이므로 건드리지 않음
+		if err := tx.Model(&TravelPlans{}).
+			Where("id = ?", planID).
+			UpdateColumn("view_count", gorm.Expr("view_count + ?", views)).Error; err != nil {
+			return err
+		}
+		return RecordPlanViews(tx, planID, views)
+	})
+}
+
+// RefreshTrendingScores 최근 30일 조회 기록과 계획 생성 시점으로 trending_score 재계산
+// 점수 = Σ(일별 조회수 × 0.5^(경과일/3)) × (1 + 0.5^(계획 나이/7))
+func RefreshTrendingScores(db *gorm.DB) error {
+	days := TrendingWindows["30d"]
+	return db.Exec(`UPDATE travel_plans p SET trending_score = COALESCE((
+	SELECT SUM(`+trendingScoreSQL+`)
+	FROM plan_daily_views v
+	WHERE v.plan_id = p.id AND v.day >= `+windowStartSQL+`
+), 0)
+WHERE p.deleted_at IS NULL
+	AND (p.trending_score <> 0 OR EXISTS (
+		SELECT 1 FROM plan_daily_views v
+		WHERE v.plan_id = p.id AND v.day >= `+windowStartSQL+`
+	))`,
+		days, days).Error
+}
+
+// trendingViews 기간 내 공개 계획의 조회 기록 (점수는 trending_score와 같은 식으로 계산)
+func trendingViews(db *gorm.DB, days int) *gorm.DB {
+	return db.Table("plan_daily_views AS v").
+		Joins("JOIN travel_plans p ON p.id = v.plan_id").
+		Where("v.day >= "+windowStartSQL, days).
+		Where("p.is_public = ? AND p.moderation_status = ? AND p.deleted_at IS NULL", true, ModerationApproved)
+}
+
+// GetTrendingPlans 기간 내 트렌딩 점수 기준 상위 공개 계획
+func GetTrendingPlans(db *gorm.DB, days, limit int) ([]TrendingPlan, error) {
+	var plans []TrendingPlan
+	err := trendingViews(db, days).
+		Select(`p.id, p.title, p.destination, p.duration, SUM(v.views) AS views, SUM(` + trendingScoreSQL + `) AS score`).
+		Group("p.id, p.title, p.destination, p.duration").
+		Order("score DESC").
+		Limit(limit).
+		Scan(&plans).Error
+	return plans, err
+}
+
+// GetTrendingDestinations 기간 내 목적지별 트렌딩 점수 합계 기준 상위 목적지
+// 여러 도시 여행은 "서울 → 부산" 같은 이은 표기 대신 구간의 도시마다 계획의 조회수와 점수를 더합니다
+// (같은 도시를 두 번 방문해도 한 번만 집계)
+func GetTrendingDestinations(db *gorm.DB, days, limit int) ([]TrendingDestination, error) {
+	var destinations []TrendingDestination
+	err := trendingViews(db, days).
+		Joins("LEFT JOIN (SELECT DISTINCT plan_id, destination FROM plan_legs) l ON l.plan_id = p.id").
+		Select(`COALESCE(l.destination, p.destination) AS destination, COUNT(DISTINCT p.id) AS plans, SUM(v.views) AS views, SUM(` + trendingScoreSQL + `) AS score`).
+		Group("COALESCE(l.destination, p.destination)").
+		Order("score DESC").
+		Limit(limit).
+		Scan(&destinations).Error
+	return destinations, err
+}
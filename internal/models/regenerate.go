@@ -0,0 +1,192 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"tripwand-backend/internal/calendar"
+)
+
+// RegenerateRequest 기존 일정의 하루 또는 특정 시간대 재생성 요청
+type RegenerateRequest struct {
+	Period       *string `json:"period,omitempty" validate:"omitempty,oneof=morning afternoon evening night" example:"afternoon"`
+	Instructions *string `json:"instructions,omitempty" example:"실내 활동 위주로 바꿔주세요"`
+}
+
+// RegenerateResult Gemma가 반환하는 재생성 결과
+type RegenerateResult struct {
+	DayPlan       *DayItinerary   `json:"day_plan,omitempty"`
+	Activity      *ActivityPeriod `json:"activity,omitempty"`
+	EstimatedCost int             `json:"estimated_cost"`
+	DayBudget     *DayBudget      `json:"day_budget,omitempty"` // 예산 내역이 있는 일정만 - 해당 일차 전체의 항목별 비용
+}
+
+// Scope 재생성 범위 설명 (예: "2일차 오후")
+func (rr *RegenerateRequest) Scope(day int) string {
+	if rr.Period != nil && *rr.Period != "" {
+		return fmt.Sprintf("%d일차 %s", day, PeriodLabels[*rr.Period])
+	}
+	return fmt.Sprintf("%d일차", day)
+}
+
+// ToGemmaPrompt 나머지 일정을 맥락으로 포함한 재생성 프롬프트 생성
+func (rr *RegenerateRequest) ToGemmaPrompt(plan *TravelPlans, current *TravelResponse, day int) string {
+	itineraryJSON, _ := json.MarshalIndent(current.Itinerary, "", "  ")
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `%s을(를) %d일 동안 여행하는 일정입니다.
+나이대는 %s이고, %s이서 여행합니다.
+여행 목적은 "%s"이며, 여행 스타일은 "%s"입니다.
+
+현재 전체 일정은 다음과 같습니다:
+%s
+
+현재 1인 기준 예상 비용은 %d원입니다.
+
+`,
+		plan.Destination, plan.Duration,
+		nonEmpty(plan.AgeGroup, "연령대 미지정"), getGroupSizeText(nonZero(plan.GroupSize)),
+		nonEmpty(plan.Purpose, "일반적인 관광"), nonEmpty(plan.TravelType, "균형잡힌 여행"),
+		itineraryJSON, current.EstimatedCost)
+
+	if rr.Period != nil && *rr.Period != "" {
+		fmt.Fprintf(&b, `이 중 %s(%s) 일정만 새로 만들어주세요. 앞뒤 일정과 자연스럽게 이어지고, 다른 날과 겹치지 않아야 합니다.
+
+다음 JSON 형식으로 정확히 답변해주세요. 다른 설명이나 부가 텍스트 없이 오직 JSON만 반환하세요:
+
+{
+  "activity": {
+    "summary": "활동 요약",
+    "detail": "활동 상세 설명"
+  },
+  "estimated_cost": 변경된 일정을 반영한 전체 여행 예상비용(숫자만)
+}`, rr.Scope(day), *rr.Period)
+	} else {
+		fmt.Fprintf(&b, `이 중 %s 일정 전체를 새로 만들어주세요. 앞뒤 날의 일정과 자연스럽게 이어지고, 다른 날과 겹치지 않아야 합니다.
+
+다음 JSON 형식으로 정확히 답변해주세요. 다른 설명이나 부가 텍스트 없이 오직 JSON만 반환하세요:
+
+{
+  "day_plan": {
+    "day": %d,
+    "morning": {"summary": "아침 활동 요약", "detail": "아침 활동 상세 설명"},
+    "afternoon": {"summary": "오후 활동 요약", "detail": "오후 활동 상세 설명"},
+    "evening": {"summary": "저녁 활동 요약", "detail": "저녁 활동 상세 설명"},
+    "night": {"summary": "밤 활동 요약", "detail": "밤 활동 상세 설명"}
+  },
+  "estimated_cost": 변경된 일정을 반영한 전체 여행 예상비용(숫자만)
+}`, rr.Scope(day), day)
+	}
+
+	if current.Budget != nil {
+		fmt.Fprintf(&b, `
+
+응답 JSON에 "day_budget": {"day": %d, "lodging": 숙박비, "food": 식비, "transport": 교통비, "activities": 입장료와 체험비} 항목으로 변경 후 %d일차 전체의 1인 비용도 숫자만 넣어주세요.`, day, day)
+	}
+
+	target := current.Itinerary[day-1]
+	if target.Date != "" {
+		weekday := target.Weekday
+		if date, err := time.Parse(calendar.DateLayout, target.Date); err == nil {
+			weekday = calendar.WeekdayLabel(date.Weekday())
+		}
+		fmt.Fprintf(&b, "\n\n%d일차 날짜는 %s %s입니다.", day, target.Date, weekday)
+		if target.Holiday != "" {
+			fmt.Fprintf(&b, " 공휴일(%s)이니 혼잡을 고려해주세요.", target.Holiday)
+		}
+	}
+
+	// 여러 도시 여행은 그날 머무는 도시와 이동 여부를 유지
+	switch {
+	case target.TransferFrom != "":
+		fmt.Fprintf(&b, "\n\n%d일차는 %s에서 %s(으)로 이동하는 날입니다. 아침에 이동하고 도착 후 %s 안에서 일정을 구성하며, 이동 수단과 소요 시간을 detail에 포함해주세요.",
+			day, target.TransferFrom, target.Destination, target.Destination)
+	case target.Destination != "":
+		fmt.Fprintf(&b, "\n\n%d일차에는 %s에 머무르므로 모든 활동을 %s 안에서 구성해주세요.", day, target.Destination, target.Destination)
+	}
+
+	if constraints := constraintsPrompt(plan.Constraints); constraints != "" {
+		b.WriteString("\n\n" + constraints)
+	}
+
+	if rr.Instructions != nil && *rr.Instructions != "" {
+		fmt.Fprintf(&b, "\n\n추가 요청사항: %s", *rr.Instructions)
+	}
+
+	b.WriteString("\n\n예상 비용은 1인 기준 한국 원화로 계산해주세요.")
+
+	if plan.Language != "" && plan.Language != "ko" {
+		b.WriteString("\n\nPlease provide all responses in English.")
+	}
+
+	return b.String()
+}
+
+// Apply 재생성 결과를 일정에 반영 (지정한 일차/시간대만 교체)
+func (rr *RegenerateRequest) Apply(current *TravelResponse, day int, result *RegenerateResult) error {
+	if day < 1 || day > len(current.Itinerary) {
+		return fmt.Errorf("day %d is out of range", day)
+	}
+	target := &current.Itinerary[day-1]
+
+	if rr.Period != nil && *rr.Period != "" {
+		if result.Activity == nil || result.Activity.Summary == "" {
+			return fmt.Errorf("regenerated activity is empty")
+		}
+		period := target.Period(*rr.Period)
+		if period == nil {
+			return fmt.Errorf("unknown period: %s", *rr.Period)
+		}
+		*period = *result.Activity
+	} else {
+		if result.DayPlan == nil {
+			return fmt.Errorf("regenerated day plan is empty")
+		}
+		replacement := *result.DayPlan
+		replacement.Day = day
+		// 날짜와 머무는 도시 정보는 AI 응답이 아닌 기존 일정 기준으로 유지
+		replacement.Date, replacement.Weekday, replacement.Holiday = target.Date, target.Weekday, target.Holiday
+		replacement.Destination, replacement.TransferFrom = target.Destination, target.TransferFrom
+		for _, name := range PeriodNames {
+			if replacement.Period(name).Summary == "" {
+				return fmt.Errorf("regenerated day plan is missing %s", name)
+			}
+		}
+		*target = replacement
+	}
+
+	// 비용을 돌려주지 않은 경우 기존 비용 유지
+	if result.EstimatedCost > 0 {
+		current.EstimatedCost = result.EstimatedCost
+	}
+
+	// 예산 내역이 있으면 해당 일차 비용만 바꾸고 합계는 항목 기준으로 다시 계산
+	if current.Budget != nil && result.DayBudget != nil {
+		for i := range current.Budget.Days {
+			if current.Budget.Days[i].Day == day {
+				current.Budget.Days[i].BudgetItems = result.DayBudget.BudgetItems
+			}
+		}
+	}
+	if current.Budget != nil {
+		current.Reconcile(current.Budget.GroupSize, current.Budget.Limit)
+	}
+
+	return nil
+}
+
+func nonEmpty(value, defaultValue string) string {
+	if value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+func nonZero(value int) *int {
+	if value == 0 {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+// legResponse 서울 1박 → 부산 1박 일정 (날짜와 구간 기록 포함)
+func legResponse() *TravelResponse {
+	tr := &TravelResponse{Itinerary: []DayItinerary{
+		testDay(1, "경복궁", "인사동", "명동", "남산"),
+		testDay(2, "KTX 이동", "해운대", "광안리", "서면"),
+		testDay(3, "감천마을", "자갈치", "남포동", "귀가"),
+	}}
+	tr.AnnotateLegs([]TripLeg{{Destination: "서울", Nights: 1}, {Destination: "부산", Nights: 1}})
+	for i := range tr.Itinerary {
+		tr.Itinerary[i].Date = []string{"2026-10-02", "2026-10-03", "2026-10-04"}[i]
+	}
+	tr.Itinerary[1].Holiday = "개천절"
+	return tr
+}
+
+func TestRegenerateApplyKeepsLegAndDate(t *testing.T) {
+	current := legResponse()
+	rr := &RegenerateRequest{}
+	result := &RegenerateResult{DayPlan: &DayItinerary{
+		Day:          9,
+		Destination:  "제주",
+		TransferFrom: "",
+		Morning:      ActivityPeriod{Summary: "새 아침"},
+		Afternoon:    ActivityPeriod{Summary: "새 오후"},
+		Evening:      ActivityPeriod{Summary: "새 저녁"},
+		Night:        ActivityPeriod{Summary: "새 밤"},
+	}}
+
+	if err := rr.Apply(current, 2, result); err != nil {
+		t.Fatal(err)
+	}
+
+	day := current.Itinerary[1]
+	if day.Day != 2 || day.Morning.Summary != "새 아침" {
+		t.Errorf("got day %d morning %q, want day 2 replaced", day.Day, day.Morning.Summary)
+	}
+	if day.Destination != "부산" || day.TransferFrom != "서울" {
+		t.Errorf("got destination %q transfer from %q, want 부산 from 서울", day.Destination, day.TransferFrom)
+	}
+	if day.Date != "2026-10-03" || day.Holiday != "개천절" {
+		t.Errorf("got date %q holiday %q, want kept", day.Date, day.Holiday)
+	}
+}
+
+func TestRegeneratePromptStatesDayCity(t *testing.T) {
+	plan := &TravelPlans{Destination: "서울 → 부산", Duration: 3, Language: "ko"}
+	current := legResponse()
+	rr := &RegenerateRequest{}
+
+	tests := []struct {
+		day  int
+		want string
+	}{
+		{day: 1, want: "1일차에는 서울에 머무르므로"},
+		{day: 2, want: "2일차는 서울에서 부산(으)로 이동하는 날입니다"},
+		{day: 3, want: "3일차에는 부산에 머무르므로"},
+	}
+	for _, tt := range tests {
+		if prompt := rr.ToGemmaPrompt(plan, current, tt.day); !strings.Contains(prompt, tt.want) {
+			t.Errorf("day %d prompt missing %q", tt.day, tt.want)
+		}
+	}
+
+	single := &TravelResponse{Itinerary: []DayItinerary{testDay(1, "a", "b", "c", "d")}}
+	if prompt := rr.ToGemmaPrompt(&TravelPlans{Destination: "부산", Duration: 1}, single, 1); strings.Contains(prompt, "머무르므로") {
+		t.Error("single-city prompt should not state a leg city")
+	}
+}
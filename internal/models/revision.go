@@ -0,0 +1,203 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 리비전 생성 사유 (Action)
+const (
+	RevisionActionInitial    = "initial"    // 리비전 기록 이전에 저장된 기존 일정
+	RevisionActionGenerate   = "generate"   // AI 일정 생성
+	RevisionActionEdit       = "edit"       // 사용자가 직접 편집
+	RevisionActionRegenerate = "regenerate" // 일부 일정 재생성
+	RevisionActionRevert     = "revert"     // 이전 리비전으로 되돌림
+	RevisionActionFork       = "fork"       // 다른 사용자의 공개 계획을 복사
+)
+
+// PlanRevision 모델 - 여행 계획 일정의 변경 이력 (생성 후 수정 불가)
+type PlanRevision struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	PlanID    uint      `gorm:"not null;uniqueIndex:idx_plan_revision" json:"plan_id"`
+	Revision  int       `gorm:"not null;uniqueIndex:idx_plan_revision" json:"revision"`
+	AuthorID  *uint     `gorm:"index" json:"author_id"` // nullable - 비회원 생성 일정
+	Action    string    `gorm:"size:20;not null" json:"action"`
+	Reason    string    `gorm:"size:255" json:"reason"`
+	PlanData  string    `gorm:"type:text" json:"plan_data,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (PlanRevision) TableName() string {
+	return "plan_revisions"
+}
+
+// BeforeUpdate 리비전은 불변이므로 수정 차단
+func (r *PlanRevision) BeforeUpdate(tx *gorm.DB) error {
+	return errors.New("plan revisions are immutable")
+}
+
+// RecordPlanRevision plan.PlanData를 새 리비전으로 기록
+// 리비전 기록이 없는 기존 계획이면 previousData를 먼저 초기 리비전으로 남깁니다.
+// 리비전 번호 경합을 막기 위해 트랜잭션 안에서 호출해야 합니다
+func RecordPlanRevision(tx *gorm.DB, plan *TravelPlans, previousData string, authorID *uint, action, reason string) (*PlanRevision, error) {
+	// 같은 계획에 대한 동시 기록 직렬화
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").Where("id = ?", plan.ID).
+		First(&TravelPlans{}).Error; err != nil {
+		return nil, err
+	}
+
+	var latest int
+	if err := tx.Model(&PlanRevision{}).
+		Where("plan_id = ?", plan.ID).
+		Select("COALESCE(MAX(revision), 0)").
+		Scan(&latest).Error; err != nil {
+		return nil, err
+	}
+
+	if latest == 0 && previousData != "" {
+		initial := PlanRevision{
+			PlanID:   plan.ID,
+			Revision: 1,
+			AuthorID: plan.UserID,
+			Action:   RevisionActionInitial,
+			PlanData: previousData,
+		}
+		if err := tx.Create(&initial).Error; err != nil {
+			return nil, err
+		}
+		latest = 1
+	}
+
+	revision := PlanRevision{
+		PlanID:   plan.ID,
+		Revision: latest + 1,
+		AuthorID: authorID,
+		Action:   action,
+		Reason:   reason,
+		PlanData: plan.PlanData,
+	}
+	if err := tx.Create(&revision).Error; err != nil {
+		return nil, err
+	}
+
+	return &revision, nil
+}
+
+// ParsePlanData 저장된 PlanData JSON을 TravelResponse로 변환
+func ParsePlanData(planData string) (*TravelResponse, error) {
+	var resp TravelResponse
+	if err := json.Unmarshal([]byte(planData), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// 일정 비교 상태
+const (
+	DiffAdded     = "added"
+	DiffRemoved   = "removed"
+	DiffChanged   = "changed"
+	DiffUnchanged = "unchanged"
+)
+
+// PeriodDiff 시간대별 변경 내용
+type PeriodDiff struct {
+	Period string          `json:"period" example:"afternoon"`
+	Status string          `json:"status" example:"changed"`
+	Before *ActivityPeriod `json:"before,omitempty"`
+	After  *ActivityPeriod `json:"after,omitempty"`
+}
+
+// DayDiff 일차별 변경 내용
+type DayDiff struct {
+	Day     int          `json:"day" example:"2"`
+	Status  string       `json:"status" example:"changed"`
+	Periods []PeriodDiff `json:"periods,omitempty"`
+}
+
+// PlanDiff 두 일정 간의 비교 결과
+type PlanDiff struct {
+	Days            []DayDiff `json:"days"`
+	CostBefore      int       `json:"cost_before"`
+	CostAfter       int       `json:"cost_after"`
+	CautionsAdded   []string  `json:"cautions_added"`
+	CautionsRemoved []string  `json:"cautions_removed"`
+}
+
+// DiffPlans 두 일정을 일차/시간대 단위로 비교
+func DiffPlans(before, after *TravelResponse) PlanDiff {
+	diff := PlanDiff{
+		CostBefore:      before.EstimatedCost,
+		CostAfter:       after.EstimatedCost,
+		CautionsAdded:   []string{},
+		CautionsRemoved: []string{},
+	}
+
+	days := len(before.Itinerary)
+	if len(after.Itinerary) > days {
+		days = len(after.Itinerary)
+	}
+
+	for i := 0; i < days; i++ {
+		dayDiff := DayDiff{Day: i + 1}
+
+		switch {
+		case i >= len(before.Itinerary):
+			dayDiff.Status = DiffAdded
+			newDay := after.Itinerary[i]
+			for _, name := range PeriodNames {
+				newPeriod := *newDay.Period(name)
+				dayDiff.Periods = append(dayDiff.Periods, PeriodDiff{Period: name, Status: DiffAdded, After: &newPeriod})
+			}
+		case i >= len(after.Itinerary):
+			dayDiff.Status = DiffRemoved
+			oldDay := before.Itinerary[i]
+			for _, name := range PeriodNames {
+				oldPeriod := *oldDay.Period(name)
+				dayDiff.Periods = append(dayDiff.Periods, PeriodDiff{Period: name, Status: DiffRemoved, Before: &oldPeriod})
+			}
+		default:
+			dayDiff.Status = DiffUnchanged
+			oldDay, newDay := before.Itinerary[i], after.Itinerary[i]
+			for _, name := range PeriodNames {
+				oldPeriod, newPeriod := *oldDay.Period(name), *newDay.Period(name)
+				if oldPeriod == newPeriod {
+					continue
+				}
+				dayDiff.Status = DiffChanged
+				dayDiff.Periods = append(dayDiff.Periods, PeriodDiff{
+					Period: name,
+					Status: DiffChanged,
+					Before: &oldPeriod,
+					After:  &newPeriod,
+				})
+			}
+		}
+
+		diff.Days = append(diff.Days, dayDiff)
+	}
+
+	oldCautions := make(map[string]bool, len(before.Cautions))
+	for _, caution := range before.Cautions {
+		oldCautions[caution] = true
+	}
+	newCautions := make(map[string]bool, len(after.Cautions))
+	for _, caution := range after.Cautions {
+		newCautions[caution] = true
+		if !oldCautions[caution] {
+			diff.CautionsAdded = append(diff.CautionsAdded, caution)
+		}
+	}
+	for _, caution := range before.Cautions {
+		if !newCautions[caution] {
+			diff.CautionsRemoved = append(diff.CautionsRemoved, caution)
+		}
+	}
+
+	return diff
+}
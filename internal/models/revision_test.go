@@ -0,0 +1,126 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+// testDay 시간대 요약만 채운 하루 일정
+func testDay(day int, morning, afternoon, evening, night string) DayItinerary {
+	return DayItinerary{
+		Day:       day,
+		Morning:   ActivityPeriod{Summary: morning},
+		Afternoon: ActivityPeriod{Summary: afternoon},
+		Evening:   ActivityPeriod{Summary: evening},
+		Night:     ActivityPeriod{Summary: night},
+	}
+}
+
+func TestDiffPlans(t *testing.T) {
+	base := &TravelResponse{
+		Itinerary: []DayItinerary{
+			testDay(1, "해운대", "광안리", "서면", "야경"),
+			testDay(2, "감천마을", "자갈치", "남포동", "호텔"),
+		},
+		EstimatedCost: 300000,
+		Cautions:      []string{"날씨 확인", "예약 필수"},
+	}
+
+	tests := []struct {
+		name       string
+		after      *TravelResponse
+		wantStatus []string
+		wantPeriod map[int][]string // 일차별 변경된 시간대
+		added      []string
+		removed    []string
+	}{
+		{
+			name:       "unchanged",
+			after:      base,
+			wantStatus: []string{DiffUnchanged, DiffUnchanged},
+			wantPeriod: map[int][]string{},
+			added:      []string{},
+			removed:    []string{},
+		},
+		{
+			name: "one period changed and cautions swapped",
+			after: &TravelResponse{
+				Itinerary: []DayItinerary{
+					testDay(1, "해운대", "광안리", "서면", "야경"),
+					testDay(2, "감천마을", "국제시장", "남포동", "호텔"),
+				},
+				EstimatedCost: 320000,
+				Cautions:      []string{"날씨 확인", "현금 준비"},
+			},
+			wantStatus: []string{DiffUnchanged, DiffChanged},
+			wantPeriod: map[int][]string{2: {"afternoon"}},
+			added:      []string{"현금 준비"},
+			removed:    []string{"예약 필수"},
+		},
+		{
+			name: "day added",
+			after: &TravelResponse{
+				Itinerary: append(append([]DayItinerary{}, base.Itinerary...), testDay(3, "태종대", "영도", "카페", "숙소")),
+				Cautions:  base.Cautions,
+			},
+			wantStatus: []string{DiffUnchanged, DiffUnchanged, DiffAdded},
+			wantPeriod: map[int][]string{3: PeriodNames},
+			added:      []string{},
+			removed:    []string{},
+		},
+		{
+			name: "day removed",
+			after: &TravelResponse{
+				Itinerary: base.Itinerary[:1],
+				Cautions:  base.Cautions,
+			},
+			wantStatus: []string{DiffUnchanged, DiffRemoved},
+			wantPeriod: map[int][]string{2: PeriodNames},
+			added:      []string{},
+			removed:    []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := DiffPlans(base, tt.after)
+
+			if len(diff.Days) != len(tt.wantStatus) {
+				t.Fatalf("got %d days, want %d", len(diff.Days), len(tt.wantStatus))
+			}
+			for i, day := range diff.Days {
+				if day.Day != i+1 || day.Status != tt.wantStatus[i] {
+					t.Errorf("day %d: got day %d status %q, want %q", i+1, day.Day, day.Status, tt.wantStatus[i])
+				}
+				var periods []string
+				for _, period := range day.Periods {
+					periods = append(periods, period.Period)
+				}
+				if !reflect.DeepEqual(periods, tt.wantPeriod[day.Day]) {
+					t.Errorf("day %d: got periods %v, want %v", day.Day, periods, tt.wantPeriod[day.Day])
+				}
+			}
+
+			if diff.CostBefore != base.EstimatedCost || diff.CostAfter != tt.after.EstimatedCost {
+				t.Errorf("got cost %d -> %d, want %d -> %d", diff.CostBefore, diff.CostAfter, base.EstimatedCost, tt.after.EstimatedCost)
+			}
+			if !reflect.DeepEqual(diff.CautionsAdded, tt.added) {
+				t.Errorf("got cautions added %v, want %v", diff.CautionsAdded, tt.added)
+			}
+			if !reflect.DeepEqual(diff.CautionsRemoved, tt.removed) {
+				t.Errorf("got cautions removed %v, want %v", diff.CautionsRemoved, tt.removed)
+			}
+		})
+	}
+}
+
+func TestDiffPlansChangedPeriodKeepsBothSides(t *testing.T) {
+	before := &TravelResponse{Itinerary: []DayItinerary{testDay(1, "a", "b", "c", "d")}}
+	after := &TravelResponse{Itinerary: []DayItinerary{testDay(1, "a", "b", "c", "e")}}
+
+	diff := DiffPlans(before, after)
+	period := diff.Days[0].Periods[0]
+	if period.Period != "night" || period.Before.Summary != "d" || period.After.Summary != "e" {
+		t.Errorf("got %+v, want night d -> e", period)
+	}
+}
@@ -0,0 +1,135 @@
+package models
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// VisibilityPublic 목록과 검색에 노출되는 공개 계획
+	VisibilityPublic = "public"
+	// VisibilityUnlisted 목록에는 없고 공유 링크로만 볼 수 있는 계획
+	VisibilityUnlisted = "unlisted"
+	// VisibilityPrivate 소유자만 볼 수 있는 계획
+	VisibilityPrivate = "private"
+
+	// shareTokenBytes 공유 토큰 난수 크기 (base64url 43자)
+	shareTokenBytes = 32
+	// ogDescriptionLength 링크 미리보기 설명 최대 길이 (글자 수)
+	ogDescriptionLength = 160
+)
+
+// OpenGraph 링크 미리보기용 메타데이터
+type OpenGraph struct {
+	Title       string `json:"title" example:"부산 3일 여행"`
+	Description string `json:"description" example:"부산 3일 여행 일정 · 1일차 해운대 해변 산책, 광안리 야경"`
+	Type        string `json:"type" example:"article"`
+}
+
+// AfterFind 조회 후 응답용 공개 범위 계산
+func (tp *TravelPlans) AfterFind(tx *gorm.DB) error {
+	tp.ResolveVisibility(time.Now())
+	return nil
+}
+
+// ShareActive 만료되지 않은 공유 링크가 있는지 확인
+func (tp *TravelPlans) ShareActive(now time.Time) bool {
+	return tp.ShareToken != nil && (tp.ShareExpiresAt == nil || now.Before(*tp.ShareExpiresAt))
+}
+
+// ResolveVisibility 공개 여부와 공유 링크 상태로 Visibility 설정
+func (tp *TravelPlans) ResolveVisibility(now time.Time) {
+	switch {
+	case tp.IsPublic:
+		tp.Visibility = VisibilityPublic
+	case tp.ShareActive(now):
+		tp.Visibility = VisibilityUnlisted
+	default:
+		tp.Visibility = VisibilityPrivate
+	}
+}
+
+// EnableShare 새 공유 토큰 발급 (기존 링크는 무효화) - expiresAt이 nil이면 만료 없음
+func EnableShare(db *gorm.DB, plan *TravelPlans, expiresAt *time.Time) error {
+	buf := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return fmt.Errorf("failed to generate share token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	if err := db.Model(plan).Updates(map[string]interface{}{
+		"share_token":      token,
+		"share_expires_at": expiresAt,
+	}).Error; err != nil {
+		return err
+	}
+
+	plan.ShareToken = &token
+	plan.ShareExpiresAt = expiresAt
+	plan.ResolveVisibility(time.Now())
+	return nil
+}
+
+// RevokeShare 공유 링크 해제
+func RevokeShare(db *gorm.DB, plan *TravelPlans) error {
+	if err := db.Model(plan).Updates(map[string]interface{}{
+		"share_token":      nil,
+		"share_expires_at": nil,
+	}).Error; err != nil {
+		return err
+	}
+
+	plan.ShareToken = nil
+	plan.ShareExpiresAt = nil
+	plan.ResolveVisibility(time.Now())
+	return nil
+}
+
+// FindSharedPlan 공유 토큰으로 계획 조회 (만료됐거나 검수를 통과하지 못한 계획은 제외)
+func FindSharedPlan(db *gorm.DB, token string, now time.Time) (*TravelPlans, error) {
+	var plan TravelPlans
+	err := db.Where("share_token = ? AND moderation_status = ?", token, ModerationApproved).
+		Where("(share_expires_at IS NULL OR share_expires_at > ?)", now).
+		First(&plan).Error
+	if err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+// OpenGraph 링크 미리보기 메타데이터 (소유자 메모는 노출하지 않고 일정 요약으로 설명 구성)
+func (tp *TravelPlans) OpenGraph() OpenGraph {
+	title := tp.Title
+	if title == "" {
+		title = DefaultPlanTitle(tp.Destination, tp.Duration)
+	}
+
+	description := fmt.Sprintf("%s %d일 여행 일정", tp.Destination, tp.Duration)
+	if data, err := ParsePlanData(tp.PlanData); err == nil && len(data.Itinerary) > 0 {
+		day := data.Itinerary[0]
+		var summaries []string
+		for _, name := range PeriodNames {
+			if summary := day.Period(name).Summary; summary != "" {
+				summaries = append(summaries, summary)
+			}
+		}
+		if len(summaries) > 0 {
+			description += fmt.Sprintf(" · %d일차 %s", day.Day, strings.Join(summaries, ", "))
+		}
+	}
+
+	if runes := []rune(description); len(runes) > ogDescriptionLength {
+		description = string(runes[:ogDescriptionLength-1]) + "…"
+	}
+
+	return OpenGraph{
+		Title:       title,
+		Description: description,
+		Type:        "article",
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"time"
 
+	"tripwand-backend/internal/calendar"
+	"tripwand-backend/internal/climate"
+
 	"gorm.io/gorm"
 )
 
@@ -16,12 +19,54 @@ type TravelRequest struct {
 	GroupSize   *int    `json:"group_size,omitempty" validate:"omitempty,min=1,max=50" example:"2"`
 	Purpose     *string `json:"purpose,omitempty" example:"힐링과 휴식"`
 	TravelType  *string `json:"travel_type,omitempty" example:"여유로운 여행"`
+
+	// 여행 속도와 하루 일정 선호 (선택) - 생성 후 일차별 활동 수와 시각을 검사
+	Pace                *string `json:"pace,omitempty" validate:"omitempty,oneof=relaxed balanced packed" example:"relaxed"`
+	WakeUpTime          *string `json:"wake_up_time,omitempty" example:"09:00"`                            // 첫 활동 시작 가능 시각 (HH:MM)
+	DayEndTime          *string `json:"day_end_time,omitempty" example:"21:00"`                            // 마지막 활동 종료 시각 (HH:MM)
+	MaxActivitiesPerDay *int    `json:"max_activities_per_day,omitempty" validate:"omitempty,min=1,max=4"` // 미지정 시 속도 기본값 (relaxed 2, balanced 3, packed 4)
+	RestDays            []int   `json:"rest_days,omitempty" example:"3"`                                   // 활동을 1개만 넣는 휴식일 (일차)
+
+	// 이동 가능성 검사 옵션
+	TransportMode *string `json:"transport_mode,omitempty" validate:"omitempty,oneof=walking transit car" example:"transit"` // 주요 이동 수단 (기본 transit)
+	RepairRoutes  *bool   `json:"repair_routes,omitempty" example:"true"`                                                    // 이동이 불가능한 날을 AI에게 다시 구성 요청
+
+	// 여행 날짜 (선택) - 종료일은 시작일과 기간으로 계산되며, 지정하면 기간과 일치해야 함
+	StartDate *string `json:"start_date,omitempty" example:"2026-10-03"`
+	EndDate   *string `json:"end_date,omitempty" example:"2026-10-05"`
+
+	// 1인 예산 (원화, 선택) - stay_under 모드는 예상 비용이 예산을 넘으면 다시 생성
+	Budget     *int    `json:"budget,omitempty" validate:"omitempty,min=1" example:"600000"`
+	BudgetMode *string `json:"budget_mode,omitempty" validate:"omitempty,oneof=target stay_under" example:"stay_under"`
+
+	// 비용을 함께 환산해 보여줄 통화 (선택, 예: USD) - 일정 자체는 원화 기준으로 생성
+	Currency *string `json:"currency,omitempty" example:"USD"`
+
+	// 여러 도시 여행 구간 (선택, 방문 순서대로) - 지정하면 destination과 duration은 구간으로 계산
+	Legs []TripLeg `json:"legs,omitempty"`
+
+	// 여행자 제약 조건 (선택) - 생성 후 조건에 맞지 않는 활동을 검사하고, 저장된 계획 필터에 사용
+	Constraints *TravelerConstraints `json:"constraints,omitempty"`
 }
 
 // ActivityPeriod 하루 중 시간대별 활동
 type ActivityPeriod struct {
-	Summary string `json:"summary" example:"부산 해운대 해변 산책"`
-	Detail  string `json:"detail" example:"새벽 일출을 보며 해변을 걷고, 근처 카페에서 아침 식사를 즐깁니다."`
+	Summary   string `json:"summary" example:"부산 해운대 해변 산책"`
+	Detail    string `json:"detail" example:"새벽 일출을 보며 해변을 걷고, 근처 카페에서 아침 식사를 즐깁니다."`
+	StartTime string `json:"start_time,omitempty" example:"07:00"` // HH:MM (선택) - 캘린더 내보내기 등에 사용
+	EndTime   string `json:"end_time,omitempty" example:"09:30"`   // HH:MM (선택)
+}
+
+// ActivityTimeLayout 활동 시작/종료 시각 형식
+const ActivityTimeLayout = "15:04"
+
+// ClockMinutes HH:MM 시각을 자정부터의 분으로 변환 (비어 있거나 형식이 틀리면 false)
+func ClockMinutes(clock string) (int, bool) {
+	t, err := time.Parse(ActivityTimeLayout, clock)
+	if err != nil {
+		return 0, false
+	}
+	return t.Hour()*60 + t.Minute(), true
 }
 
 // DayItinerary 하루 일정
@@ -31,40 +76,300 @@ type DayItinerary struct {
 	Afternoon ActivityPeriod `json:"afternoon"`
 	Evening   ActivityPeriod `json:"evening"`
 	Night     ActivityPeriod `json:"night"`
+
+	// 여행 날짜를 지정한 경우에만 채워짐
+	Date    string `json:"date,omitempty" example:"2026-10-03"`
+	Weekday string `json:"weekday,omitempty" example:"Saturday"`
+	Holiday string `json:"holiday,omitempty" example:"개천절"`
+
+	// 여러 도시 여행에서만 채워짐 - 그날 머무는 도시와 이동일의 출발 도시
+	Destination  string `json:"destination,omitempty" example:"부산"`
+	TransferFrom string `json:"transfer_from,omitempty" example:"서울"`
+}
+
+// PeriodNames 하루 일정의 시간대 이름 (시간 순서)
+var PeriodNames = []string{"morning", "afternoon", "evening", "night"}
+
+// PeriodLabels 시간대 이름의 한국어 표기
+var PeriodLabels = map[string]string{
+	"morning":   "아침",
+	"afternoon": "오후",
+	"evening":   "저녁",
+	"night":     "밤",
+}
+
+// Period 시간대 이름으로 해당 활동 조회 (알 수 없는 이름이면 nil)
+func (d *DayItinerary) Period(name string) *ActivityPeriod {
+	switch name {
+	case "morning":
+		return &d.Morning
+	case "afternoon":
+		return &d.Afternoon
+	case "evening":
+		return &d.Evening
+	case "night":
+		return &d.Night
+	}
+	return nil
 }
 
 // TravelResponse 프론트엔드로 반환하는 여행 일정 응답
 type TravelResponse struct {
-	Itinerary     []DayItinerary `json:"itinerary"`
-	EstimatedCost int            `json:"estimated_cost" example:"500000"`
-	Cautions      []string       `json:"cautions" example:"날씨 확인 필수,예약 미리 하기"`
+	Itinerary     []DayItinerary   `json:"itinerary"`
+	EstimatedCost int              `json:"estimated_cost" example:"500000"`
+	Cautions      []string         `json:"cautions" example:"날씨 확인 필수,예약 미리 하기"`
+	Climate       *climate.Summary `json:"climate,omitempty"` // 여행 날짜를 지정한 경우 여행 시기의 평년 기후
+	Budget        *BudgetBreakdown `json:"budget,omitempty"`  // 항목별/일별 예산 내역 (estimated_cost는 per_person_total과 같음)
 }
 
 // TravelPlans 데이터베이스에 저장할 여행 계획 (선택사항)
 type TravelPlans struct {
-	ID          uint           `gorm:"primaryKey" json:"id"`
-	UserID      *uint          `gorm:"index" json:"user_id"` // nullable - 비회원도 사용 가능
-	Destination string         `gorm:"size:255;not null" json:"destination"`
-	Duration    int            `gorm:"not null" json:"duration"`
-	AgeGroup    string         `gorm:"size:50" json:"age_group"`
-	GroupSize   int            `json:"group_size"`
-	Purpose     string         `gorm:"size:100" json:"purpose"`
-	TravelType  string         `gorm:"size:100" json:"travel_type"`
-	PlanData    string         `gorm:"type:text" json:"plan_data"` // JSON 형태로 저장된 여행 계획
-	IsPublic    bool           `gorm:"default:false" json:"is_public"`
-	ViewCount   int            `gorm:"default:0" json:"view_count"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+	ID               uint           `gorm:"primaryKey" json:"id"`
+	UserID           *uint          `gorm:"index" json:"user_id"`   // nullable - 비회원도 사용 가능
+	GuestID          string         `gorm:"size:64;index" json:"-"` // 비회원 생성 계획의 게스트 식별자 (로그인 후 이전)
+	Title            string         `gorm:"size:255" json:"title"`
+	Notes            string         `gorm:"type:text" json:"notes"`
+	Destination      string         `gorm:"size:255;not null" json:"destination"`
+	Duration         int            `gorm:"not null" json:"duration"`
+	Language         string         `gorm:"size:10;default:ko" json:"language"`
+	AgeGroup         string         `gorm:"size:50" json:"age_group"`
+	GroupSize        int            `json:"group_size"`
+	Purpose          string         `gorm:"size:100" json:"purpose"`
+	TravelType       string         `gorm:"size:100" json:"travel_type"`
+	PlanData         string         `gorm:"type:text" json:"plan_data"`             // JSON 형태로 저장된 여행 계획
+	StartDate        *time.Time     `gorm:"type:date" json:"start_date"`            // 1일차 날짜 (지정하지 않았으면 nil)
+	Constraints      ConstraintTags `gorm:"size:255;default:''" json:"constraints"` // 여행자 제약 조건 태그 (예: wheelchair, halal)
+	IsPublic         bool           `gorm:"default:false" json:"is_public"`
+	ShareToken       *string        `gorm:"size:64;uniqueIndex" json:"-"`                            // 목록에 노출되지 않는 공유 링크 토큰
+	ShareExpiresAt   *time.Time     `json:"share_expires_at"`                                        // nil이면 만료 없음
+	ModerationStatus string         `gorm:"size:20;default:approved;index" json:"moderation_status"` // approved만 다른 사용자에게 노출
+	ViewCount        int            `gorm:"default:0" json:"view_count"`
+	ForkedFrom       *uint          `gorm:"column:forked_from_id;index" json:"forked_from"` // 복사해 온 원본 계획 ID
+	ForkCount        int            `gorm:"default:0" json:"fork_count"`
+	LikeCount        int            `gorm:"default:0" json:"like_count"`
+	CommentCount     int            `gorm:"default:0" json:"comment_count"`
+	RatingAvg        float64        `gorm:"default:0" json:"rating_avg"` // 리뷰 평균 별점 (1~5)
+	RatingCount      int            `gorm:"default:0" json:"rating_count"`
+	TrendingScore    float64        `gorm:"default:0;index" json:"trending_score"` // 주기적으로 재계산되는 시간 감쇠 인기 점수
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	DeletedAt        gorm.DeletedAt `gorm:"index" json:"-"`
+
+	// 응답용 (컬럼 아님) - public, unlisted, private
+	Visibility string `gorm:"-" json:"visibility"`
 
 	// Relations
-	User *User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	User *User     `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Legs []PlanLeg `gorm:"foreignKey:PlanID" json:"legs,omitempty"` // 여러 도시 여행 구간 (방문 순서대로)
 }
 
 func (TravelPlans) TableName() string {
 	return "travel_plans"
 }
 
+// DefaultPlanTitle 제목이 없는 여행 계획의 기본 제목
+func DefaultPlanTitle(destination string, duration int) string {
+	return fmt.Sprintf("%s %d일 여행", destination, duration)
+}
+
+// Validate 사용자가 편집한 여행 일정이 TravelResponse 형식에 맞는지 검증
+func (tr *TravelResponse) Validate() error {
+	if len(tr.Itinerary) == 0 {
+		return fmt.Errorf("itinerary must contain at least one day")
+	}
+	if len(tr.Itinerary) > 30 {
+		return fmt.Errorf("itinerary must not exceed 30 days")
+	}
+
+	for i, day := range tr.Itinerary {
+		if day.Day != i+1 {
+			return fmt.Errorf("itinerary day %d has day number %d, days must be sequential from 1", i+1, day.Day)
+		}
+		for _, name := range PeriodNames {
+			period := day.Period(name)
+			if period.Summary == "" {
+				return fmt.Errorf("day %d %s summary is required", day.Day, name)
+			}
+			for _, value := range []string{period.StartTime, period.EndTime} {
+				if _, err := time.Parse(ActivityTimeLayout, value); value != "" && err != nil {
+					return fmt.Errorf("day %d %s time %q must be in HH:MM format", day.Day, name, value)
+				}
+			}
+		}
+	}
+
+	if tr.EstimatedCost < 0 {
+		return fmt.Errorf("estimated_cost must not be negative")
+	}
+
+	return tr.validateBudget()
+}
+
+// ForkPlan 원본 계획을 사용자 계정의 비공개 계획으로 복사하고 원본의 복사 횟수 증가
+func ForkPlan(db *gorm.DB, source *TravelPlans, userID uint) (*TravelPlans, error) {
+	fork := TravelPlans{
+		UserID:      &userID,
+		Title:       source.Title,
+		Destination: source.Destination,
+		Duration:    source.Duration,
+		Language:    source.Language,
+		AgeGroup:    source.AgeGroup,
+		GroupSize:   source.GroupSize,
+		Purpose:     source.Purpose,
+		TravelType:  source.TravelType,
+		PlanData:    source.PlanData,
+		Constraints: source.Constraints,
+		IsPublic:    false,
+		ForkedFrom:  &source.ID,
+	}
+	if fork.Title == "" {
+		fork.Title = DefaultPlanTitle(source.Destination, source.Duration)
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// 여러 도시 여행이면 구간도 함께 복사
+		var legs []PlanLeg
+		if err := tx.Where("plan_id = ?", source.ID).Order("position").Find(&legs).Error; err != nil {
+			return err
+		}
+		for _, leg := range legs {
+			fork.Legs = append(fork.Legs, PlanLeg{
+				Position:    leg.Position,
+				Destination: leg.Destination,
+				Nights:      leg.Nights,
+				StartDay:    leg.StartDay,
+			})
+		}
+
+		if err := tx.Create(&fork).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&TravelPlans{}).
+			Where("id = ?", source.ID).
+			UpdateColumn("fork_count", gorm.Expr("fork_count + 1")).Error; err != nil {
+			return err
+		}
+
+		_, err := RecordPlanRevision(tx, &fork, "", &userID, RevisionActionFork, fmt.Sprintf("계획 #%d에서 복사", source.ID))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &fork, nil
+}
+
+// PurgeDeletedPlans 휴지통에서 cutoff 이전에 삭제된 여행 계획을 영구 삭제
+// 삭제된 계획 수를 반환합니다
+func PurgeDeletedPlans(db *gorm.DB, cutoff time.Time) (int64, error) {
+	var purged int64
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var planIDs []uint
+		if err := tx.Unscoped().Model(&TravelPlans{}).
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+			Pluck("id", &planIDs).Error; err != nil {
+			return err
+		}
+		if len(planIDs) == 0 {
+			return nil
+		}
+
+		// 계획에 딸린 데이터 먼저 삭제
+		if err := tx.Where("plan_id IN ?", planIDs).Delete(&PlanRevision{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("plan_id IN ?", planIDs).Delete(&PlanLeg{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("plan_id IN ?", planIDs).Delete(&PlanDailyView{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("plan_id IN ?", planIDs).Delete(&PlanLike{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("plan_id IN ?", planIDs).Delete(&PlanBookmark{}).Error; err != nil {
+			return err
+		}
+		var commentIDs []uint
+		if err := tx.Unscoped().Model(&PlanComment{}).
+			Where("plan_id IN ?", planIDs).
+			Pluck("id", &commentIDs).Error; err != nil {
+			return err
+		}
+		if err := deleteModerationRecords(tx, ModerationTargetComment, commentIDs); err != nil {
+			return err
+		}
+		var reviewIDs []uint
+		if err := tx.Unscoped().Model(&PlanReview{}).
+			Where("plan_id IN ?", planIDs).
+			Pluck("id", &reviewIDs).Error; err != nil {
+			return err
+		}
+		if err := deleteModerationRecords(tx, ModerationTargetReview, reviewIDs); err != nil {
+			return err
+		}
+		if err := deleteModerationRecords(tx, ModerationTargetPlan, planIDs); err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("plan_id IN ?", planIDs).Delete(&PlanComment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("plan_id IN ?", planIDs).Delete(&PlanReview{}).Error; err != nil {
+			return err
+		}
+
+		// 복사본은 남기고 원본 참조만 해제
+		if err := tx.Unscoped().Model(&TravelPlans{}).
+			Where("forked_from_id IN ?", planIDs).
+			Update("forked_from_id", nil).Error; err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Where("id IN ?", planIDs).Delete(&TravelPlans{})
+		if result.Error != nil {
+			return result.Error
+		}
+		purged = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+// ClaimGuestPlans 게스트가 생성한 여행 계획을 사용자 계정으로 이전 (원자적 처리)
+// 이전된 계획 수를 반환합니다
+func ClaimGuestPlans(db *gorm.DB, guestID string, userID uint) (int64, error) {
+	if guestID == "" {
+		return 0, nil
+	}
+
+	var claimed int64
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// 휴지통에 있는 계획도 함께 이전
+		result := tx.Unscoped().Model(&TravelPlans{}).
+			Where("guest_id = ? AND user_id IS NULL", guestID).
+			Updates(map[string]interface{}{
+				"user_id":  userID,
+				"guest_id": "",
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		claimed = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return claimed, nil
+}
+
 // GemmaPromptData Gemma에 전달할 프롬프트 데이터
 type GemmaPromptData struct {
 	Destination string
@@ -84,7 +389,7 @@ func (tr *TravelRequest) ToGemmaPrompt() string {
 		AgeGroup:    getStringValue(tr.AgeGroup, "연령대 미지정"),
 		GroupSize:   getGroupSizeText(tr.GroupSize),
 		Purpose:     getStringValue(tr.Purpose, "일반적인 관광"),
-		TravelType:  getStringValue(tr.TravelType, "균형잡힌 여행"),
+		TravelType:  tr.TravelTypeOrDefault(),
 		Language:    getStringValue(tr.Language, "ko"),
 	}
 
@@ -117,12 +422,47 @@ func (tr *TravelRequest) ToGemmaPrompt() string {
     }
   ],
   "estimated_cost": 예상비용(숫자만),
+  "budget": {
+    "days": [
+      {"day": 1, "lodging": 숙박비, "food": 식비, "transport": 교통비, "activities": 입장료와 체험비}
+    ]
+  },
   "cautions": ["주의사항1", "주의사항2"]
 }
 
-각 일차별로 현실적이고 구체적인 일정을 만들어주세요. 예상 비용은 1인 기준 한국 원화로 계산해주세요.`,
+각 일차별로 현실적이고 구체적인 일정을 만들어주세요. 예상 비용은 1인 기준 한국 원화로 계산해주세요.
+budget.days에는 일차마다 항목별 1인 비용을 숫자만 넣고, estimated_cost는 모든 항목의 합계와 같아야 합니다.`,
 		data.Destination, data.Duration, data.AgeGroup, data.GroupSize, data.Purpose, data.TravelType)
 
+	if label, ok := TransportModeLabels[getStringValue(tr.TransportMode, "")]; ok {
+		prompt += fmt.Sprintf(`
+
+주요 이동 수단은 %s입니다. 같은 날 연속된 활동은 %s(으)로 무리 없이 이동할 수 있는 거리로 구성해주세요.`, label, label)
+	}
+
+	if len(tr.Legs) > 0 {
+		prompt += "\n\n" + legsPrompt(tr.Legs)
+	}
+
+	if constraints := constraintsPrompt(tr.Constraints.Tags()); constraints != "" {
+		prompt += "\n\n" + constraints
+	}
+
+	if schedule, err := tr.Schedule(); err == nil && schedule != nil {
+		prompt += "\n\n" + schedule.prompt()
+	}
+
+	if budget := tr.budgetPrompt(); budget != "" {
+		prompt += "\n\n" + budget
+	}
+
+	if start, err := tr.TripStart(); err == nil && start != nil {
+		prompt += "\n\n" + datePrompt(calendar.Korea().Days(*start, tr.Duration))
+		if summary := tr.ClimateSummary(*start); summary != nil {
+			prompt += "\n\n" + summary.Prompt()
+		}
+	}
+
 	// language가 "ko"가 아닌 경우 영어 응답 요청 추가
 	if data.Language != "ko" {
 		prompt += `
@@ -133,6 +473,18 @@ Please provide all responses in English.`
 	return prompt
 }
 
+// TransportModeLabels 이동 수단의 한국어 표기
+var TransportModeLabels = map[string]string{
+	"walking": "도보",
+	"transit": "대중교통",
+	"car":     "자동차",
+}
+
+// LanguageOrDefault 요청 언어 (미지정 시 "ko")
+func LanguageOrDefault(language *string) string {
+	return getStringValue(language, "ko")
+}
+
 // 헬퍼 함수들
 func getStringValue(ptr *string, defaultValue string) string {
 	if ptr != nil && *ptr != "" {
@@ -14,6 +14,7 @@ type User struct {
 	Nickname        string         `gorm:"size:100" json:"nickname"`
 	ProfileImageURL string         `gorm:"size:500" json:"profile_image_url"`
 	IsActive        bool           `gorm:"default:true" json:"is_active"`
+	IsAdmin         bool           `gorm:"default:false" json:"is_admin"` // 검수 등 관리자 기능 접근 권한
 	LastLoginAt     *time.Time     `json:"last_login_at"`
 	CreatedAt       time.Time      `json:"created_at"`
 	UpdatedAt       time.Time      `json:"updated_at"`
@@ -24,6 +25,17 @@ type User struct {
 	TravelPlans   []TravelPlans  `gorm:"foreignKey:UserID" json:"travels,omitempty"`
 }
 
+// PublicProfile 다른 사용자에게 노출되는 작성자 정보 (users 테이블의 일부 컬럼)
+type PublicProfile struct {
+	ID              uint   `json:"id"`
+	Nickname        string `json:"nickname"`
+	ProfileImageURL string `json:"profile_image_url"`
+}
+
+func (PublicProfile) TableName() string {
+	return "users"
+}
+
 // OAuthAccount 모델 - OAuth 제공자별 계정 정보
 type OAuthAccount struct {
 	ID           uint       `gorm:"primaryKey" json:"id"`
@@ -0,0 +1,174 @@
+// internal/moderation/moderation.go
+package moderation
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+
+	"tripwand-backend/internal/llm"
+	"tripwand-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// CheckBlocklist 금칙어 검사
+	CheckBlocklist = "blocklist"
+	// CheckPII 개인정보 노출 검사
+	CheckPII = "pii"
+	// CheckLLM LLM 안전 카테고리 분류
+	CheckLLM = "llm"
+)
+
+// defaultBlocklist 기본 금칙어 (MODERATION_BLOCKLIST 환경변수로 쉼표 구분 추가 가능)
+var defaultBlocklist = []string{
+	"씨발", "씨8", "ㅅㅂ", "개새끼", "병신", "좆", "fuck",
+}
+
+// piiPatterns 개인정보로 간주하는 패턴 (가게 대표번호 등 일반 전화번호는 제외)
+var piiPatterns = []struct {
+	Category string
+	Pattern  *regexp.Regexp
+}{
+	{Category: "phone_number", Pattern: regexp.MustCompile(`(?:\+82[-\s]?|\b0)1[016789][-\s.]?\d{3,4}[-\s.]?\d{4}\b`)},
+	{Category: "email", Pattern: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)},
+	{Category: "resident_registration_number", Pattern: regexp.MustCompile(`\b\d{2}(?:0[1-9]|1[0-2])(?:0[1-9]|[12]\d|3[01])[-\s]?[1-4]\d{6}\b`)},
+}
+
+// Finding 검사에 걸린 항목
+type Finding struct {
+	Check    string `json:"check"`
+	Category string `json:"category"`
+	Detail   string `json:"detail,omitempty"`
+}
+
+// Service 콘텐츠 자동 검수 서비스
+type Service struct {
+	gemma     *llm.GemmaClient
+	blocklist []string
+}
+
+// NewService 새로운 검수 서비스 생성 (gemma가 nil이면 LLM 분류는 건너뜀)
+func NewService(gemma *llm.GemmaClient) *Service {
+	blocklist := append([]string{}, defaultBlocklist...)
+	for _, word := range strings.Split(os.Getenv("MODERATION_BLOCKLIST"), ",") {
+		if word = strings.TrimSpace(word); word != "" {
+			blocklist = append(blocklist, word)
+		}
+	}
+
+	return &Service{
+		gemma:     gemma,
+		blocklist: blocklist,
+	}
+}
+
+// QuickCheck 금칙어와 개인정보 패턴만 검사 (요청 처리 중 동기 실행용)
+func (s *Service) QuickCheck(text string) []Finding {
+	var findings []Finding
+
+	// 띄어쓰기로 금칙어를 피하는 경우까지 검사
+	normalized := strings.ToLower(strings.Join(strings.Fields(text), ""))
+	for _, word := range s.blocklist {
+		if strings.Contains(normalized, strings.ToLower(word)) {
+			findings = append(findings, Finding{Check: CheckBlocklist, Category: "profanity", Detail: word})
+		}
+	}
+
+	for _, pii := range piiPatterns {
+		if match := pii.Pattern.FindString(text); match != "" {
+			findings = append(findings, Finding{Check: CheckPII, Category: pii.Category, Detail: mask(match)})
+		}
+	}
+
+	return findings
+}
+
+// Check 금칙어/개인정보 검사 후 LLM 안전 카테고리 분류까지 수행
+func (s *Service) Check(text string) ([]Finding, error) {
+	findings := s.QuickCheck(text)
+	if len(findings) > 0 || s.gemma == nil {
+		return findings, nil
+	}
+
+	result, err := s.gemma.ClassifySafety(text)
+	if err != nil {
+		return nil, err
+	}
+	if result.Flagged {
+		categories := result.Categories
+		if len(categories) == 0 {
+			categories = []string{"unspecified"}
+		}
+		for _, category := range categories {
+			findings = append(findings, Finding{Check: CheckLLM, Category: category, Detail: result.Reason})
+		}
+	}
+
+	return findings, nil
+}
+
+// Review 콘텐츠를 검사해 통과하면 노출하고, 걸리면 숨긴 채 검토 큐에 등록
+// 통과해도 신고 누적으로 검토를 기다리거나 관리자가 이미 결정한 콘텐츠는 그대로 둡니다 (models.ApproveContent)
+// LLM 분류에 실패하면 관리자가 직접 확인하도록 검토 큐에 등록합니다
+func (s *Service) Review(db *gorm.DB, targetType string, targetID uint, text string) {
+	findings, err := s.Check(text)
+	if err != nil {
+		log.Printf("⚠️ Failed to classify %s %d: %v", targetType, targetID, err)
+		findings = []Finding{{Check: CheckLLM, Category: "unavailable", Detail: err.Error()}}
+	}
+
+	if len(findings) == 0 {
+		if _, err := models.ApproveContent(db, targetType, targetID); err != nil {
+			log.Printf("⚠️ Failed to approve %s %d: %v", targetType, targetID, err)
+		}
+		return
+	}
+
+	if err := Flag(db, targetType, targetID, findings); err != nil {
+		log.Printf("⚠️ Failed to flag %s %d: %v", targetType, targetID, err)
+	}
+}
+
+// Flag 검사 결과와 함께 콘텐츠를 숨기고 검토 큐에 등록
+func Flag(db *gorm.DB, targetType string, targetID uint, findings []Finding) error {
+	data, err := json.Marshal(findings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal findings: %w", err)
+	}
+	return models.FlagContent(db, targetType, targetID, string(data))
+}
+
+// PlanText 여행 계획에서 검수할 텍스트 (제목, 메모, 일정 내용)
+func PlanText(plan *models.TravelPlans) string {
+	parts := []string{plan.Title, plan.Destination, plan.Notes}
+
+	data, err := models.ParsePlanData(plan.PlanData)
+	if err != nil {
+		// 형식이 맞지 않으면 원본 그대로 검사
+		return strings.Join(append(parts, plan.PlanData), "\n")
+	}
+
+	for i := range data.Itinerary {
+		for _, name := range models.PeriodNames {
+			period := data.Itinerary[i].Period(name)
+			parts = append(parts, period.Summary, period.Detail)
+		}
+	}
+	parts = append(parts, data.Cautions...)
+
+	return strings.Join(parts, "\n")
+}
+
+// mask 검토 화면에 개인정보 원문이 그대로 남지 않도록 가운데 부분 가림
+func mask(value string) string {
+	runes := []rune(value)
+	if len(runes) <= 4 {
+		return strings.Repeat("*", len(runes))
+	}
+	return string(runes[:2]) + strings.Repeat("*", len(runes)-4) + string(runes[len(runes)-2:])
+}
@@ -0,0 +1,97 @@
+package moderation
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"tripwand-backend/internal/models"
+)
+
+func TestQuickCheck(t *testing.T) {
+	t.Setenv("MODERATION_BLOCKLIST", " 스팸광고 ,, 도박 ")
+	s := NewService(nil)
+
+	tests := []struct {
+		name string
+		text string
+		want []Finding
+	}{
+		{name: "clean", text: "해운대에서 일출 보고 돼지국밥 먹기"},
+		{name: "blocklist word", text: "이 일정 병신 같네", want: []Finding{{Check: CheckBlocklist, Category: "profanity", Detail: "병신"}}},
+		{name: "blocklist split by spaces", text: "씨 발 너무 덥다", want: []Finding{{Check: CheckBlocklist, Category: "profanity", Detail: "씨발"}}},
+		{name: "blocklist case insensitive", text: "What the FUCK", want: []Finding{{Check: CheckBlocklist, Category: "profanity", Detail: "fuck"}}},
+		{name: "blocklist from environment", text: "온라인 도박 사이트", want: []Finding{{Check: CheckBlocklist, Category: "profanity", Detail: "도박"}}},
+		{name: "mobile number", text: "문의는 010-1234-5678로", want: []Finding{{Check: CheckPII, Category: "phone_number", Detail: "01*********78"}}},
+		{name: "mobile number with spaces", text: "연락처 010 1234 5678", want: []Finding{{Check: CheckPII, Category: "phone_number", Detail: "01*********78"}}},
+		{name: "international mobile number", text: "+82-10-1234-5678", want: []Finding{{Check: CheckPII, Category: "phone_number", Detail: "+8************78"}}},
+		{name: "store number is not pii", text: "예약 051-123-4567, 서울 02-1234-5678"},
+		{name: "email", text: "메일 trip.user@example.com 으로", want: []Finding{{Check: CheckPII, Category: "email", Detail: "tr*****************om"}}},
+		{name: "resident registration number", text: "주민번호 900101-1234567", want: []Finding{{Check: CheckPII, Category: "resident_registration_number", Detail: "90**********67"}}},
+		{name: "date and price are not pii", text: "2026-10-18 입장료 15000원"},
+		{
+			name: "blocklist before pii",
+			text: "ㅅㅂ 010-1234-5678",
+			want: []Finding{
+				{Check: CheckBlocklist, Category: "profanity", Detail: "ㅅㅂ"},
+				{Check: CheckPII, Category: "phone_number", Detail: "01*********78"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.QuickCheck(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("QuickCheck(%q) = %+v, want %+v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckWithoutLLM(t *testing.T) {
+	s := NewService(nil)
+
+	findings, err := s.Check("맛집 추천")
+	if err != nil || len(findings) != 0 {
+		t.Errorf("got %+v, %v, want no findings", findings, err)
+	}
+	findings, err = s.Check("fuck")
+	if err != nil || len(findings) != 1 || findings[0].Check != CheckBlocklist {
+		t.Errorf("got %+v, %v, want blocklist finding", findings, err)
+	}
+}
+
+func TestMask(t *testing.T) {
+	tests := map[string]string{
+		"":              "",
+		"abcd":          "****",
+		"abcde":         "ab*de",
+		"010-1234-5678": "01*********78",
+		"홍길동전화번호":       "홍길***번호",
+	}
+	for value, want := range tests {
+		if got := mask(value); got != want {
+			t.Errorf("mask(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
+
+func TestPlanText(t *testing.T) {
+	plan := &models.TravelPlans{
+		Title:       "부산 여행",
+		Destination: "부산",
+		Notes:       "메모",
+		PlanData:    `{"itinerary":[{"day":1,"morning":{"summary":"해운대 산책","detail":"해변 걷기"}}],"cautions":["주의사항"]}`,
+	}
+	text := PlanText(plan)
+	for _, want := range []string{"부산 여행", "메모", "해운대 산책", "해변 걷기", "주의사항"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("PlanText missing %q: %q", want, text)
+		}
+	}
+
+	plan.PlanData = "not json 010-1234-5678"
+	if text := PlanText(plan); !strings.Contains(text, plan.PlanData) {
+		t.Errorf("PlanText with invalid plan data = %q, want raw plan data", text)
+	}
+}
@@ -0,0 +1,205 @@
+// internal/stats/stats.go
+package stats
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"tripwand-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+const (
+	dailyWindowDays   = 30
+	weeklyWindowWeeks = 12
+	topDestinationMax = 10
+	distributionMax   = 10
+)
+
+// Bucket 항목별 개수
+type Bucket struct {
+	Key   string `json:"key" example:"부산"`
+	Count int64  `json:"count" example:"42"`
+}
+
+// TravelStats 여행 계획 통계 스냅샷
+type TravelStats struct {
+	TotalPlans          int64     `json:"total_plans_generated" example:"1234"`
+	PublicPlans         int64     `json:"public_plans" example:"980"`
+	PlansToday          int64     `json:"plans_today" example:"12"`
+	PlansPerDay         []Bucket  `json:"plans_per_day"`  // 최근 30일, key는 YYYY-MM-DD
+	PlansPerWeek        []Bucket  `json:"plans_per_week"` // 최근 12주, key는 주 시작일(월요일)
+	PopularDestinations []string  `json:"popular_destinations" example:"부산,제주도,서울"`
+	TopDestinations     []Bucket  `json:"top_destinations"`
+	AverageDuration     float64   `json:"average_duration" example:"3.2"`
+	AgeGroups           []Bucket  `json:"age_groups"`
+	GroupSizes          []Bucket  `json:"group_sizes"`
+	TravelTypes         []Bucket  `json:"travel_types"`
+	GeneratedAt         time.Time `json:"generated_at"`
+}
+
+var current atomic.Pointer[TravelStats]
+
+// Current 마지막으로 계산된 통계 스냅샷 (아직 계산 전이면 nil)
+func Current() *TravelStats {
+	return current.Load()
+}
+
+// Refresh 통계를 다시 계산해 스냅샷 교체
+func Refresh(db *gorm.DB) (*TravelStats, error) {
+	snapshot, err := Compute(db, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	current.Store(snapshot)
+	return snapshot, nil
+}
+
+// Compute travel_plans 테이블에서 통계 집계
+func Compute(db *gorm.DB, now time.Time) (*TravelStats, error) {
+	result := &TravelStats{GeneratedAt: now}
+	plans := func() *gorm.DB { return db.Model(&models.TravelPlans{}) }
+
+	if err := plans().Count(&result.TotalPlans).Error; err != nil {
+		return nil, fmt.Errorf("failed to count plans: %w", err)
+	}
+	if err := plans().Where("is_public = ? AND moderation_status = ?", true, models.ModerationApproved).Count(&result.PublicPlans).Error; err != nil {
+		return nil, fmt.Errorf("failed to count public plans: %w", err)
+	}
+
+	today := now.Truncate(24 * time.Hour)
+	if err := plans().Where("created_at >= ?", today).Count(&result.PlansToday).Error; err != nil {
+		return nil, fmt.Errorf("failed to count today's plans: %w", err)
+	}
+
+	var avg *float64
+	if err := plans().Select("AVG(duration)").Scan(&avg).Error; err != nil {
+		return nil, fmt.Errorf("failed to average duration: %w", err)
+	}
+	if avg != nil {
+		result.AverageDuration = float64(int(*avg*10+0.5)) / 10
+	}
+
+	var err error
+	if result.PlansPerDay, err = countByPeriod(plans(), "day", today.AddDate(0, 0, -(dailyWindowDays-1)), now); err != nil {
+		return nil, err
+	}
+	weekStart := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7)) // 이번 주 월요일
+	if result.PlansPerWeek, err = countByPeriod(plans(), "week", weekStart.AddDate(0, 0, -7*(weeklyWindowWeeks-1)), now); err != nil {
+		return nil, err
+	}
+
+	if result.TopDestinations, err = countByColumn(plans(), "destination", topDestinationMax); err != nil {
+		return nil, err
+	}
+	result.PopularDestinations = make([]string, 0, len(result.TopDestinations))
+	for _, bucket := range result.TopDestinations {
+		result.PopularDestinations = append(result.PopularDestinations, bucket.Key)
+	}
+
+	if result.AgeGroups, err = countByColumn(plans(), "age_group", distributionMax); err != nil {
+		return nil, err
+	}
+	if result.TravelTypes, err = countByColumn(plans(), "travel_type", distributionMax); err != nil {
+		return nil, err
+	}
+	if result.GroupSizes, err = countGroupSizes(plans()); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// countByPeriod since~until 사이 생성된 계획 수를 일/주 단위로 집계 (빈 구간은 0으로 채움)
+func countByPeriod(query *gorm.DB, unit string, since, until time.Time) ([]Bucket, error) {
+	var rows []struct {
+		Period time.Time
+		Count  int64
+	}
+	if err := query.
+		Select("date_trunc(?, created_at AT TIME ZONE 'UTC') AS period, COUNT(*) AS count", unit).
+		Where("created_at >= ?", since).
+		Group("period").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to count plans per %s: %w", unit, err)
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Period.UTC().Format("2006-01-02")] = row.Count
+	}
+
+	step := 1
+	if unit == "week" {
+		step = 7
+	}
+
+	var buckets []Bucket
+	for day := since; !day.After(until); day = day.AddDate(0, 0, step) {
+		key := day.Format("2006-01-02")
+		buckets = append(buckets, Bucket{Key: key, Count: counts[key]})
+	}
+
+	return buckets, nil
+}
+
+// countByColumn 컬럼 값별 계획 수 상위 limit개 (빈 값은 "미지정")
+func countByColumn(query *gorm.DB, column string, limit int) ([]Bucket, error) {
+	var rows []Bucket
+	if err := query.
+		Select(fmt.Sprintf("COALESCE(NULLIF(%s, ''), '미지정') AS key, COUNT(*) AS count", column)).
+		Group("key").
+		Order("count DESC").
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to count plans by %s: %w", column, err)
+	}
+	return rows, nil
+}
+
+// countGroupSizes 인원수 구간별 계획 수
+func countGroupSizes(query *gorm.DB) ([]Bucket, error) {
+	var rows []struct {
+		GroupSize int
+		Count     int64
+	}
+	if err := query.
+		Select("group_size, COUNT(*) AS count").
+		Group("group_size").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to count plans by group size: %w", err)
+	}
+
+	order := []string{"1명", "2명", "3-4명", "5명 이상", "미지정"}
+	counts := make(map[string]int64, len(order))
+	for _, row := range rows {
+		counts[groupSizeBucket(row.GroupSize)] += row.Count
+	}
+
+	buckets := make([]Bucket, 0, len(order))
+	for _, key := range order {
+		if counts[key] > 0 {
+			buckets = append(buckets, Bucket{Key: key, Count: counts[key]})
+		}
+	}
+	sort.SliceStable(buckets, func(i, j int) bool { return buckets[i].Count > buckets[j].Count })
+
+	return buckets, nil
+}
+
+func groupSizeBucket(size int) string {
+	switch {
+	case size <= 0:
+		return "미지정"
+	case size <= 2:
+		return strconv.Itoa(size) + "명"
+	case size <= 4:
+		return "3-4명"
+	default:
+		return "5명 이상"
+	}
+}
@@ -0,0 +1,128 @@
+// internal/views/counter.go
+package views
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"tripwand-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// DedupWindow 같은 사용자의 반복 조회를 한 번으로 보는 기간
+const DedupWindow = 30 * time.Minute
+
+// botMarkers User-Agent에 포함되면 봇으로 간주하는 문자열 (소문자)
+var botMarkers = []string{
+	"bot", "crawler", "spider", "slurp", "crawling",
+	"facebookexternalhit", "embedly", "preview", "headless",
+	"curl", "wget", "python-requests", "go-http-client", "httpclient",
+}
+
+// DefaultCounter 서버 전역 조회수 카운터
+var DefaultCounter = NewCounter(DedupWindow)
+
+// Counter 조회수 중복 제거 및 버퍼링 카운터
+// 조회는 메모리에 모았다가 Flush 시 계획별로 한 번에 반영해 행 경합을 줄입니다
+type Counter struct {
+	mu      sync.Mutex
+	window  time.Duration
+	seen    map[string]time.Time // "planID:viewerKey" -> 마지막으로 집계한 시각
+	pending map[uint]int64       // planID -> 아직 반영하지 않은 조회수
+}
+
+// NewCounter 새로운 조회수 카운터 생성
+func NewCounter(window time.Duration) *Counter {
+	return &Counter{
+		window:  window,
+		seen:    make(map[string]time.Time),
+		pending: make(map[uint]int64),
+	}
+}
+
+// Record 조회 1회를 기록 (중복 조회면 false)
+func (vc *Counter) Record(planID uint, viewerKey string, now time.Time) bool {
+	key := fmt.Sprintf("%d:%s", planID, viewerKey)
+
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+
+	if last, ok := vc.seen[key]; ok && now.Sub(last) < vc.window {
+		return false
+	}
+
+	vc.seen[key] = now
+	vc.pending[planID]++
+	return true
+}
+
+// Flush 버퍼에 쌓인 조회수를 데이터베이스에 반영하고 만료된 중복 기록 정리
+func (vc *Counter) Flush(db *gorm.DB, now time.Time) error {
+	pending := vc.drain(now)
+
+	// 잠금 순서를 일정하게 유지해 교착 상태 방지
+	planIDs := make([]uint, 0, len(pending))
+	for planID := range pending {
+		planIDs = append(planIDs, planID)
+	}
+	sort.Slice(planIDs, func(i, j int) bool { return planIDs[i] < planIDs[j] })
+
+	var firstErr error
+	for _, planID := range planIDs {
+		if err := models.IncrementPlanViews(db, planID, pending[planID]); err != nil {
+			// 실패한 조회수는 다음 Flush에서 다시 시도
+			vc.mu.Lock()
+			vc.pending[planID] += pending[planID]
+			vc.mu.Unlock()
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// drain 버퍼에 쌓인 조회수를 꺼내고 만료된 중복 기록 정리
+func (vc *Counter) drain(now time.Time) map[uint]int64 {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+
+	pending := vc.pending
+	vc.pending = make(map[uint]int64)
+	for key, last := range vc.seen {
+		if now.Sub(last) >= vc.window {
+			delete(vc.seen, key)
+		}
+	}
+	return pending
+}
+
+// ViewerKey 조회자 식별 키 (로그인 사용자는 ID, 비회원은 IP+User-Agent 해시)
+func ViewerKey(userID *uint, ip, userAgent string) string {
+	if userID != nil {
+		return fmt.Sprintf("user:%d", *userID)
+	}
+	sum := sha256.Sum256([]byte(ip + "|" + userAgent))
+	return "anon:" + hex.EncodeToString(sum[:16])
+}
+
+// IsBot User-Agent로 봇/크롤러 여부 판단 (User-Agent가 없으면 봇으로 간주)
+func IsBot(userAgent string) bool {
+	ua := strings.ToLower(strings.TrimSpace(userAgent))
+	if ua == "" {
+		return true
+	}
+	for _, marker := range botMarkers {
+		if strings.Contains(ua, marker) {
+			return true
+		}
+	}
+	return false
+}
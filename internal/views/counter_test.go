@@ -0,0 +1,151 @@
+package views
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func uintPtr(n uint) *uint {
+	return &n
+}
+
+func TestRecord(t *testing.T) {
+	start := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		planID  uint
+		viewer  string
+		elapsed time.Duration
+		want    bool
+	}{
+		{name: "first view", planID: 1, viewer: "user:7", elapsed: 0, want: true},
+		{name: "same viewer within window", planID: 1, viewer: "user:7", elapsed: 10 * time.Minute, want: false},
+		{name: "just before window ends", planID: 1, viewer: "user:7", elapsed: DedupWindow - time.Second, want: false},
+		{name: "other viewer", planID: 1, viewer: "user:8", elapsed: 10 * time.Minute, want: true},
+		{name: "same viewer on other plan", planID: 2, viewer: "user:7", elapsed: 10 * time.Minute, want: true},
+		{name: "same viewer after window", planID: 1, viewer: "user:7", elapsed: DedupWindow, want: true},
+		{name: "window restarts from last counted view", planID: 1, viewer: "user:7", elapsed: DedupWindow + 10*time.Minute, want: false},
+	}
+
+	vc := NewCounter(DedupWindow)
+	for _, tt := range tests {
+		if got := vc.Record(tt.planID, tt.viewer, start.Add(tt.elapsed)); got != tt.want {
+			t.Errorf("%s: Record = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	if want := map[uint]int64{1: 3, 2: 1}; !reflect.DeepEqual(vc.pending, want) {
+		t.Errorf("got pending %v, want %v", vc.pending, want)
+	}
+}
+
+func TestDrain(t *testing.T) {
+	start := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	vc := NewCounter(DedupWindow)
+	vc.Record(1, "user:7", start)
+	vc.Record(1, "user:8", start.Add(20*time.Minute))
+	vc.Record(2, "user:7", start)
+
+	if got, want := vc.drain(start.Add(DedupWindow)), map[uint]int64{1: 2, 2: 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got drained %v, want %v", got, want)
+	}
+	if got := vc.drain(start.Add(DedupWindow)); len(got) != 0 {
+		t.Errorf("got %v after drain, want empty buffer", got)
+	}
+	// 만료된 기록만 정리되어 user:8의 최근 조회는 계속 중복으로 처리
+	if _, ok := vc.seen["1:user:7"]; ok {
+		t.Error("expired dedup entry was not pruned")
+	}
+	if vc.Record(1, "user:8", start.Add(DedupWindow)) {
+		t.Error("recent view was counted again after drain")
+	}
+}
+
+func TestFlushKeepsFailedViews(t *testing.T) {
+	// 연결할 수 없는 데이터베이스 - 반영에 실패한 조회수는 다음 Flush를 위해 버퍼에 남아야 함
+	db, err := gorm.Open(postgres.Open("host=127.0.0.1 port=1 user=test dbname=test sslmode=disable connect_timeout=1"), &gorm.Config{
+		DisableAutomaticPing: true,
+		Logger:               logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	vc := NewCounter(DedupWindow)
+	vc.Record(1, "user:7", now)
+	vc.Record(2, "user:7", now)
+
+	if err := vc.Flush(db, now); err == nil {
+		t.Fatal("expected error from unreachable database")
+	}
+	if want := map[uint]int64{1: 1, 2: 1}; !reflect.DeepEqual(vc.pending, want) {
+		t.Errorf("got pending %v after failed flush, want %v", vc.pending, want)
+	}
+}
+
+func TestViewerKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		userID    *uint
+		ip        string
+		userAgent string
+		sameAs    string // 같은 키가 나와야 하는 다른 입력의 이름
+	}{
+		{name: "user", userID: uintPtr(7), ip: "10.0.0.1", userAgent: "Mozilla/5.0"},
+		{name: "user other device", userID: uintPtr(7), ip: "10.0.0.2", userAgent: "Safari", sameAs: "user"},
+		{name: "anonymous", ip: "10.0.0.1", userAgent: "Mozilla/5.0"},
+		{name: "anonymous same client", ip: "10.0.0.1", userAgent: "Mozilla/5.0", sameAs: "anonymous"},
+		{name: "anonymous other agent", ip: "10.0.0.1", userAgent: "Safari"},
+		{name: "anonymous other ip", ip: "10.0.0.2", userAgent: "Mozilla/5.0"},
+	}
+
+	keys := make(map[string]string)
+	owners := make(map[string]string)
+	for _, tt := range tests {
+		key := ViewerKey(tt.userID, tt.ip, tt.userAgent)
+		keys[tt.name] = key
+		if tt.sameAs != "" {
+			if key != keys[tt.sameAs] {
+				t.Errorf("%s: got key %q, want same as %s %q", tt.name, key, tt.sameAs, keys[tt.sameAs])
+			}
+			continue
+		}
+		if owner, ok := owners[key]; ok {
+			t.Errorf("%s: key %q collides with %s", tt.name, key, owner)
+		}
+		owners[key] = tt.name
+	}
+
+	if got := keys["user"]; got != "user:7" {
+		t.Errorf("got user key %q, want user:7", got)
+	}
+}
+
+func TestIsBot(t *testing.T) {
+	tests := []struct {
+		userAgent string
+		want      bool
+	}{
+		{userAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 Mobile/15E148", want: false},
+		{userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) Chrome/126.0 Safari/537.36", want: false},
+		{userAgent: "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)", want: true},
+		{userAgent: "facebookexternalhit/1.1", want: true},
+		{userAgent: "Mozilla/5.0 HeadlessChrome/126.0", want: true},
+		{userAgent: "curl/8.5.0", want: true},
+		{userAgent: "python-requests/2.32", want: true},
+		{userAgent: "  ", want: true},
+		{userAgent: "", want: true},
+	}
+	for _, tt := range tests {
+		if got := IsBot(tt.userAgent); got != tt.want {
+			t.Errorf("IsBot(%q) = %v, want %v", tt.userAgent, got, tt.want)
+		}
+	}
+}